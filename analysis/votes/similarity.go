@@ -0,0 +1,383 @@
+package votes
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// MemberRef는 행렬과 목록에서 의원을 가리키는 정보입니다.
+type MemberRef struct {
+	Code  string `json:"code"`
+	Name  string `json:"name"`
+	Party string `json:"party"` // 가장 최근 표결의 정당
+}
+
+// Matrix는 의원 쌍의 표결 일치율 행렬입니다. Members[i]와 Members[j]가 둘 다 참석한 법안 Shared[i][j]건 중
+// 같은 결과(찬성, 반대, 기권)로 표결한 비율이 Agreement[i][j]입니다. 함께 참석한 법안이 없으면 둘 다 0입니다.
+type Matrix struct {
+	Members   []MemberRef `json:"members"`
+	Agreement [][]float64 `json:"agreement"`
+	Shared    [][]int     `json:"shared"`
+	index     map[string]int
+}
+
+// Neighbor는 어떤 의원과 표결이 비슷한 의원입니다.
+type Neighbor struct {
+	MemberRef
+	Agreement float64 `json:"agreement"`
+	Shared    int     `json:"shared"`
+}
+
+// IdealPoint는 표결 행렬을 축소한 좌표입니다. 비슷하게 표결한 의원일수록 가깝습니다.
+type IdealPoint struct {
+	MemberRef
+	Coords []float64 `json:"coords"`
+}
+
+// IdealPoints는 IdealPoints 메서드의 결과입니다.
+type IdealPoints struct {
+	Bills     int          `json:"bills"`     // 계산에 쓴 법안 수
+	Explained []float64    `json:"explained"` // 차원별 설명된 분산 비율
+	Points    []IdealPoint `json:"points"`    // 이름, 코드 순
+}
+
+// choiceGrid는 의원×법안 표결 결과입니다. 0은 기록 없음이고, 그 밖에는 Choice+1입니다.
+type choiceGrid struct {
+	members []MemberRef
+	cells   [][]byte
+}
+
+// grid는 같은 의원과 법안의 첫 행만 남겨 표결 결과 격자를 만듭니다. 참석 표결이 minVotes건 미만인 의원은 뺍니다.
+func (a *Analyzer) grid(minVotes int) choiceGrid {
+	cells := make([][]byte, len(a.members))
+	for i := range cells {
+		cells[i] = make([]byte, len(a.bills))
+	}
+	party := make([]int32, len(a.members))
+	lastDate := make([]string, len(a.members))
+	seen := make([]bool, len(a.members))
+	for _, v := range a.votes {
+		if cells[v.member][v.bill] == 0 {
+			cells[v.member][v.bill] = byte(v.choice) + 1
+		}
+		if date := a.bills[v.bill].date; !seen[v.member] || date >= lastDate[v.member] {
+			seen[v.member], lastDate[v.member], party[v.member] = true, date, v.party
+		}
+	}
+
+	var g choiceGrid
+	for i, m := range a.members {
+		present := 0
+		for _, c := range cells[i] {
+			if c != 0 && voted(Choice(c-1)) {
+				present++
+			}
+		}
+		if present < max(minVotes, 1) {
+			continue
+		}
+		g.members = append(g.members, MemberRef{Code: m.code, Name: m.name, Party: a.parties[party[i]]})
+		g.cells = append(g.cells, cells[i])
+	}
+
+	order := make([]int, len(g.members))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(x, y int) int {
+		if c := strings.Compare(g.members[x].Name, g.members[y].Name); c != 0 {
+			return c
+		}
+		return strings.Compare(g.members[x].Code, g.members[y].Code)
+	})
+	sorted := choiceGrid{members: make([]MemberRef, len(order)), cells: make([][]byte, len(order))}
+	for i, o := range order {
+		sorted.members[i], sorted.cells[i] = g.members[o], g.cells[o]
+	}
+	return sorted
+}
+
+// Agreement는 참석 표결이 minVotes건 이상인 의원의 일치율 행렬을 계산합니다. 의원은 이름, 코드 순입니다.
+func (a *Analyzer) Agreement(minVotes int) *Matrix {
+	g := a.grid(minVotes)
+	n := len(g.members)
+	m := &Matrix{Members: g.members, Agreement: make([][]float64, n), Shared: make([][]int, n), index: make(map[string]int, n)}
+	for i := range n {
+		m.Agreement[i] = make([]float64, n)
+		m.Shared[i] = make([]int, n)
+		m.index[memberKey(g.members[i].Code, g.members[i].Name)] = i
+	}
+	for i := range n {
+		for j := i; j < n; j++ {
+			shared, same := 0, 0
+			for b, x := range g.cells[i] {
+				y := g.cells[j][b]
+				if x == 0 || y == 0 || !voted(Choice(x-1)) || !voted(Choice(y-1)) {
+					continue
+				}
+				shared++
+				if x == y {
+					same++
+				}
+			}
+			m.Shared[i][j], m.Shared[j][i] = shared, shared
+			if shared > 0 {
+				r := float64(same) / float64(shared)
+				m.Agreement[i][j], m.Agreement[j][i] = r, r
+			}
+		}
+	}
+	return m
+}
+
+// Neighbors는 code(MONA_CD)와 일치율이 높은 의원을 최대 k명 반환합니다. 코드가 없는 의원은 "name:"+이름으로 찾습니다. 함께 참석한 법안이 minShared건 미만인 의원은 뺍니다.
+// 일치율이 같으면 함께 참석한 법안이 많은 순, 그다음 이름 순입니다.
+func (m *Matrix) Neighbors(code string, k, minShared int) []Neighbor {
+	i, ok := m.index[code]
+	if !ok {
+		return nil
+	}
+	var neighbors []Neighbor
+	for j, ref := range m.Members {
+		if j == i || m.Shared[i][j] == 0 || m.Shared[i][j] < minShared {
+			continue
+		}
+		neighbors = append(neighbors, Neighbor{MemberRef: ref, Agreement: m.Agreement[i][j], Shared: m.Shared[i][j]})
+	}
+	slices.SortStableFunc(neighbors, func(x, y Neighbor) int {
+		switch {
+		case x.Agreement != y.Agreement:
+			if x.Agreement > y.Agreement {
+				return -1
+			}
+			return 1
+		case x.Shared != y.Shared:
+			return y.Shared - x.Shared
+		}
+		return strings.Compare(x.Name, y.Name)
+	})
+	if k > 0 && len(neighbors) > k {
+		neighbors = neighbors[:k]
+	}
+	return neighbors
+}
+
+// WriteCSV는 행렬을 CSV로 씁니다. 첫 세 열은 code, name, party이고 그 뒤로 의원 코드별 일치율 열이 이어집니다.
+// 함께 참석한 법안이 없는 칸은 비워 둡니다.
+func (m *Matrix) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	header := []string{"code", "name", "party"}
+	for _, ref := range m.Members {
+		header = append(header, ref.Code)
+	}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for i, ref := range m.Members {
+		record := []string{ref.Code, ref.Name, ref.Party}
+		for j := range m.Members {
+			if m.Shared[i][j] == 0 {
+				record = append(record, "")
+				continue
+			}
+			record = append(record, strconv.FormatFloat(m.Agreement[i][j], 'f', 4, 64))
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// IdealPoints는 표결 행렬을 특이값 분해(SVD)해 의원마다 dims차원 좌표를 추정합니다.
+//
+// 찬성은 +1, 반대는 -1, 기권은 0으로 두고 법안마다 참석 의원의 평균을 뺀 뒤, 불참과 기록 없음은 0(평균)으로 채웁니다.
+// 그 행렬 X에서 XXᵀ의 고유벡터를 거듭제곱법으로 구하고, 좌표는 고유벡터에 특이값을 곱한 값입니다.
+// 부호는 임의이므로 차원마다 절댓값이 가장 큰 좌표가 양수가 되도록 맞춥니다.
+// 참석 표결이 minVotes건 미만인 의원은 뺍니다.
+func (a *Analyzer) IdealPoints(dims, minVotes int) (*IdealPoints, error) {
+	if dims < 1 {
+		return nil, fmt.Errorf("votes: dims must be positive, got %d", dims)
+	}
+	g := a.grid(minVotes)
+	n := len(g.members)
+	if n == 0 {
+		return &IdealPoints{}, nil
+	}
+	bills := len(a.bills)
+
+	x := make([][]float64, n)
+	for i := range x {
+		x[i] = make([]float64, bills)
+	}
+	used := 0
+	for b := range bills {
+		sum, count := 0.0, 0
+		for i := range n {
+			if v, ok := score(g.cells[i][b]); ok {
+				sum += v
+				count++
+			}
+		}
+		if count == 0 {
+			continue
+		}
+		used++
+		mean := sum / float64(count)
+		for i := range n {
+			if v, ok := score(g.cells[i][b]); ok {
+				x[i][b] = v - mean
+			}
+		}
+	}
+
+	// C = XXᵀ
+	c := make([][]float64, n)
+	trace := 0.0
+	for i := range n {
+		c[i] = make([]float64, n)
+	}
+	for i := range n {
+		for j := i; j < n; j++ {
+			s := 0.0
+			for b := range bills {
+				s += x[i][b] * x[j][b]
+			}
+			c[i][j], c[j][i] = s, s
+		}
+		trace += c[i][i]
+	}
+
+	result := &IdealPoints{Bills: used, Points: make([]IdealPoint, n)}
+	for i, ref := range g.members {
+		result.Points[i] = IdealPoint{MemberRef: ref, Coords: make([]float64, 0, dims)}
+	}
+	for range min(dims, n) {
+		vec, lambda := powerIteration(c)
+		if lambda <= 1e-12 {
+			break
+		}
+		// 부호 맞추기
+		maxIdx := 0
+		for i := range vec {
+			if math.Abs(vec[i]) > math.Abs(vec[maxIdx]) {
+				maxIdx = i
+			}
+		}
+		if vec[maxIdx] < 0 {
+			for i := range vec {
+				vec[i] = -vec[i]
+			}
+		}
+		sigma := math.Sqrt(lambda)
+		for i := range n {
+			result.Points[i].Coords = append(result.Points[i].Coords, vec[i]*sigma)
+		}
+		if trace > 0 {
+			result.Explained = append(result.Explained, lambda/trace)
+		}
+		// 찾은 성분을 빼서 다음 성분을 구합니다.
+		for i := range n {
+			for j := range n {
+				c[i][j] -= lambda * vec[i] * vec[j]
+			}
+		}
+	}
+	return result, nil
+}
+
+// WriteCSV는 좌표를 code, name, party, dim1, dim2 ... 열로 씁니다.
+func (p *IdealPoints) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	header := []string{"code", "name", "party"}
+	for d := range p.Explained {
+		header = append(header, "dim"+strconv.Itoa(d+1))
+	}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for _, pt := range p.Points {
+		record := []string{pt.Code, pt.Name, pt.Party}
+		for _, v := range pt.Coords {
+			record = append(record, strconv.FormatFloat(v, 'f', 6, 64))
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// score는 격자 값을 SVD 입력 값으로 바꿉니다. 참석하지 않았으면 false입니다.
+func score(cell byte) (float64, bool) {
+	if cell == 0 {
+		return 0, false
+	}
+	switch Choice(cell - 1) {
+	case ChoiceYes:
+		return 1, true
+	case ChoiceNo:
+		return -1, true
+	case ChoiceAbstain:
+		return 0, true
+	}
+	return 0, false
+}
+
+// powerIteration은 대칭 양의 준정부호 행렬 c의 가장 큰 고유값과 단위 고유벡터를 구합니다.
+// 시작 벡터를 고정해 실행마다 같은 결과를 냅니다.
+func powerIteration(c [][]float64) ([]float64, float64) {
+	n := len(c)
+	vec := make([]float64, n)
+	for i := range vec {
+		vec[i] = 1 + float64(i%7)/10
+	}
+	normalize(vec)
+	next := make([]float64, n)
+	lambda := 0.0
+	for range 1000 {
+		for i := range n {
+			s := 0.0
+			for j := range n {
+				s += c[i][j] * vec[j]
+			}
+			next[i] = s
+		}
+		norm := normalize(next)
+		if norm == 0 {
+			return vec, 0
+		}
+		diff := 0.0
+		for i := range n {
+			diff += math.Abs(next[i] - vec[i])
+		}
+		vec, next = next, vec
+		lambda = norm
+		if diff < 1e-12 {
+			break
+		}
+	}
+	return vec, lambda
+}
+
+func normalize(v []float64) float64 {
+	norm := 0.0
+	for _, x := range v {
+		norm += x * x
+	}
+	norm = math.Sqrt(norm)
+	if norm == 0 {
+		return 0
+	}
+	for i := range v {
+		v[i] /= norm
+	}
+	return norm
+}
@@ -0,0 +1,347 @@
+// Package votes는 국회의원 본회의 표결(nojepdqqaweusdfbi) 행을 모아 법안별, 정당별, 의원별 통계와
+// 의원 간 표결 일치율, 표결 성향 좌표를 계산합니다.
+//
+// Analyzer는 행마다 법안, 의원, 정당 번호와 표결 결과만 압축해 보관하므로 한 대수 전체(약 100만 행)도 메모리에 들어갑니다.
+//
+//	a := votes.NewAnalyzer()
+//	if err := a.AddSeq(assembly_go.Paginate(1000, fetchVotes)); err != nil { ... }
+//	report := a.Report()
+package votes
+
+import (
+	"assembly_go"
+	"assembly_go/models"
+	"cmp"
+	"iter"
+	"slices"
+	"strings"
+)
+
+// Choice는 표결 결과(RESULT_VOTE_MOD)입니다. assembly_go.TallyVotes와 같은 해석을 쓰도록 assembly_go.VoteChoice를 그대로 씁니다.
+type Choice = assembly_go.VoteChoice
+
+const (
+	ChoiceOther   = assembly_go.VoteOther   // 알 수 없는 값
+	ChoiceYes     = assembly_go.VoteYes     // 찬성
+	ChoiceNo      = assembly_go.VoteNo      // 반대
+	ChoiceAbstain = assembly_go.VoteAbstain // 기권
+	ChoiceAbsent  = assembly_go.VoteAbsent  // 불참
+)
+
+// ParseChoice는 RESULT_VOTE_MOD 값을 Choice로 바꿉니다.
+func ParseChoice(s string) Choice {
+	return assembly_go.ParseVoteChoice(s)
+}
+
+// memberKey는 의원을 구분하는 키입니다. MONA_CD가 없는 행은 "name:"+이름으로 구분합니다.
+func memberKey(code, name string) string {
+	if code == "" {
+		return "name:" + name
+	}
+	return code
+}
+
+// voted는 본회의에 참석해 찬성, 반대, 기권 중 하나로 표결했는지 알려줍니다.
+func voted(c Choice) bool {
+	return c == ChoiceYes || c == ChoiceNo || c == ChoiceAbstain
+}
+
+// BillTally는 법안 하나의 표결 집계입니다.
+type BillTally struct {
+	BillID   string                           `json:"bill_id"`
+	BillNo   string                           `json:"bill_no"`
+	BillName string                           `json:"bill_name"`
+	VoteDate string                           `json:"vote_date"`
+	Tally    assembly_go.VoteTally            `json:"tally"`
+	Parties  map[string]assembly_go.VoteTally `json:"parties"` // 정당(POLY_NM)별 집계
+}
+
+// PartyStats는 정당 하나의 표결 통계입니다.
+type PartyStats struct {
+	Party string                `json:"party"`
+	Bills int                   `json:"bills"` // 소속 의원이 한 명이라도 표결한 법안 수
+	Tally assembly_go.VoteTally `json:"tally"`
+	// Rice는 법안별 Rice 지수 |찬성-반대|/(찬성+반대)의 평균입니다. 1이면 항상 한 방향으로 표결했다는 뜻입니다.
+	// 찬성과 반대가 모두 없는 법안은 평균에서 뺍니다.
+	Rice      float64 `json:"rice"`
+	RiceBills int     `json:"rice_bills"` // Rice 평균에 들어간 법안 수
+}
+
+// MemberStats는 의원 한 명의 표결 통계입니다.
+type MemberStats struct {
+	Code  string                `json:"code"`
+	Name  string                `json:"name"`
+	Party string                `json:"party"` // 가장 최근 표결의 정당
+	Tally assembly_go.VoteTally `json:"tally"`
+	// Attendance는 표결 기록 중 찬성, 반대, 기권으로 참석한 비율입니다.
+	Attendance float64 `json:"attendance"`
+	// PartyLineVotes는 참석한 표결 중 소속 정당의 다수 의견이 있었던 수이고, Agreed는 그중 다수 의견과 같게 표결한 수입니다.
+	// 정당의 다수 의견은 그 법안에서 같은 정당 의원의 찬성, 반대, 기권 중 가장 많은 쪽이며, 동수면 없는 것으로 봅니다.
+	PartyLineVotes int     `json:"party_line_votes"`
+	Agreed         int     `json:"agreed"`
+	Agreement      float64 `json:"agreement"`
+}
+
+// Report는 Analyzer.Report의 결과입니다.
+type Report struct {
+	Bills   []BillTally   // 표결일, 의안 ID 순
+	Parties []PartyStats  // 정당 이름 순
+	Members []MemberStats // 이름, 코드 순
+}
+
+type billInfo struct {
+	id, no, name, date string
+}
+
+type memberInfo struct {
+	code, name string
+}
+
+// vote는 표결 한 건을 압축한 기록입니다.
+type vote struct {
+	bill, member, party int32
+	choice              Choice
+}
+
+// Analyzer는 표결 행을 모읍니다. 같은 법안에 같은 의원의 행이 여러 번 들어오면 처음 것만 셉니다.
+type Analyzer struct {
+	bills       []billInfo
+	billIndex   map[string]int32
+	members     []memberInfo
+	memberIndex map[string]int32
+	parties     []string
+	partyIndex  map[string]int32
+	votes       []vote
+
+	normalizeParty func(name, date string) string // nil이면 POLY_NM을 그대로 씀
+}
+
+// Option은 Analyzer 설정을 위한 함수 타입입니다.
+type Option func(*Analyzer)
+
+// WithPartyNormalizer는 표결 행의 정당 이름(POLY_NM)과 표결일(VOTE_DATE)을 받아 집계에 쓸 정당 이름을 돌려주는 함수를 설정합니다.
+// party.Registry.Normalize를 넘기면 당명이 바뀌거나 합당한 정당을 한 정당으로 묶어 집계합니다.
+func WithPartyNormalizer(normalize func(name, date string) string) Option {
+	return func(a *Analyzer) {
+		a.normalizeParty = normalize
+	}
+}
+
+// NewAnalyzer는 빈 Analyzer를 생성합니다.
+func NewAnalyzer(options ...Option) *Analyzer {
+	a := &Analyzer{
+		billIndex:   make(map[string]int32),
+		memberIndex: make(map[string]int32),
+		partyIndex:  make(map[string]int32),
+	}
+	for _, opt := range options {
+		opt(a)
+	}
+	return a
+}
+
+// Add는 표결 행을 추가합니다. 의원은 MONA_CD로, 코드가 없으면 이름으로 구분합니다. BILL_ID가 없는 행은 무시합니다.
+func (a *Analyzer) Add(rows ...models.NojepdqqaweusdfbiRow) {
+	for _, row := range rows {
+		if row.BillID == "" {
+			continue
+		}
+		b, ok := a.billIndex[row.BillID]
+		if !ok {
+			b = int32(len(a.bills))
+			a.billIndex[row.BillID] = b
+			a.bills = append(a.bills, billInfo{id: row.BillID, no: row.BillNumber, name: row.BillName, date: row.VoteDate})
+		}
+
+		key := memberKey(row.MemberCode, row.MemberName)
+		m, ok := a.memberIndex[key]
+		if !ok {
+			m = int32(len(a.members))
+			a.memberIndex[key] = m
+			a.members = append(a.members, memberInfo{code: row.MemberCode, name: row.MemberName})
+		}
+
+		party := strings.TrimSpace(row.PartyName)
+		if a.normalizeParty != nil {
+			party = a.normalizeParty(party, row.VoteDate)
+		}
+		p, ok := a.partyIndex[party]
+		if !ok {
+			p = int32(len(a.parties))
+			a.partyIndex[party] = p
+			a.parties = append(a.parties, party)
+		}
+
+		a.votes = append(a.votes, vote{bill: b, member: m, party: p, choice: ParseChoice(row.VoteResult)})
+	}
+}
+
+// AddSeq는 seq의 행을 차례로 추가합니다. assembly_go.Paginate와 함께 쓰면 페이지를 받는 대로 추가합니다.
+func (a *Analyzer) AddSeq(seq iter.Seq2[models.NojepdqqaweusdfbiRow, error]) error {
+	for row, err := range seq {
+		if err != nil {
+			return err
+		}
+		a.Add(row)
+	}
+	return nil
+}
+
+// Len은 지금까지 추가한 행 수입니다.
+func (a *Analyzer) Len() int {
+	return len(a.votes)
+}
+
+// Report는 모은 행으로 법안별 집계, 정당별 응집도, 의원별 출석률과 당론 일치율을 계산합니다.
+func (a *Analyzer) Report() *Report {
+	votes := slices.Clone(a.votes)
+	slices.SortStableFunc(votes, func(x, y vote) int {
+		return cmp.Or(cmp.Compare(x.bill, y.bill), cmp.Compare(x.member, y.member))
+	})
+	votes = slices.CompactFunc(votes, func(x, y vote) bool { return x.bill == y.bill && x.member == y.member })
+
+	parties := make([]PartyStats, len(a.parties))
+	riceSum := make([]float64, len(a.parties))
+	for i, name := range a.parties {
+		parties[i].Party = name
+	}
+	members := make([]MemberStats, len(a.members))
+	lastDate := make([]string, len(a.members))
+	for i, m := range a.members {
+		members[i].Code, members[i].Name = m.code, m.name
+	}
+
+	report := &Report{}
+	partyTally := make([]assembly_go.VoteTally, len(a.parties))
+	for start := 0; start < len(votes); {
+		end := start
+		for end < len(votes) && votes[end].bill == votes[start].bill {
+			end++
+		}
+		group := votes[start:end]
+		info := a.bills[group[0].bill]
+
+		clear(partyTally)
+		bill := BillTally{BillID: info.id, BillNo: info.no, BillName: info.name, VoteDate: info.date, Parties: make(map[string]assembly_go.VoteTally)}
+		for _, v := range group {
+			bill.Tally.Add(v.choice)
+			partyTally[v.party].Add(v.choice)
+		}
+
+		line := make(map[int32]Choice)
+		for p, t := range partyTally {
+			if t.Total == 0 {
+				continue
+			}
+			bill.Parties[a.parties[p]] = t
+			stats := &parties[p]
+			stats.Bills++
+			stats.Tally.Yes += t.Yes
+			stats.Tally.No += t.No
+			stats.Tally.Abstain += t.Abstain
+			stats.Tally.Absent += t.Absent
+			stats.Tally.Other += t.Other
+			stats.Tally.Total += t.Total
+			if t.Yes+t.No > 0 {
+				diff := t.Yes - t.No
+				if diff < 0 {
+					diff = -diff
+				}
+				riceSum[p] += float64(diff) / float64(t.Yes+t.No)
+				stats.RiceBills++
+			}
+			if c, ok := partyLine(t); ok {
+				line[int32(p)] = c
+			}
+		}
+		report.Bills = append(report.Bills, bill)
+
+		for _, v := range group {
+			stats := &members[v.member]
+			stats.Tally.Add(v.choice)
+			if info.date >= lastDate[v.member] {
+				lastDate[v.member] = info.date
+				stats.Party = a.parties[v.party]
+			}
+			if c, ok := line[v.party]; ok && voted(v.choice) {
+				stats.PartyLineVotes++
+				if v.choice == c {
+					stats.Agreed++
+				}
+			}
+		}
+		start = end
+	}
+
+	for i := range parties {
+		if parties[i].RiceBills > 0 {
+			parties[i].Rice = riceSum[i] / float64(parties[i].RiceBills)
+		}
+	}
+	for i := range members {
+		m := &members[i]
+		if m.Tally.Total > 0 {
+			m.Attendance = float64(m.Tally.Yes+m.Tally.No+m.Tally.Abstain) / float64(m.Tally.Total)
+		}
+		if m.PartyLineVotes > 0 {
+			m.Agreement = float64(m.Agreed) / float64(m.PartyLineVotes)
+		}
+	}
+
+	slices.SortStableFunc(report.Bills, func(x, y BillTally) int {
+		return cmp.Or(strings.Compare(x.VoteDate, y.VoteDate), strings.Compare(x.BillID, y.BillID))
+	})
+	slices.SortStableFunc(parties, func(x, y PartyStats) int { return strings.Compare(x.Party, y.Party) })
+	slices.SortStableFunc(members, func(x, y MemberStats) int {
+		return cmp.Or(strings.Compare(x.Name, y.Name), strings.Compare(x.Code, y.Code))
+	})
+	report.Parties, report.Members = parties, members
+	return report
+}
+
+// partyLine은 정당의 찬성, 반대, 기권 중 가장 많은 쪽을 반환합니다. 참석자가 없거나 동수면 false입니다.
+func partyLine(t assembly_go.VoteTally) (Choice, bool) {
+	best, count, tie := ChoiceOther, 0, false
+	for _, c := range []struct {
+		choice Choice
+		n      int
+	}{{ChoiceYes, t.Yes}, {ChoiceNo, t.No}, {ChoiceAbstain, t.Abstain}} {
+		switch {
+		case c.n > count:
+			best, count, tie = c.choice, c.n, false
+		case c.n == count && c.n > 0:
+			tie = true
+		}
+	}
+	return best, count > 0 && !tie
+}
+
+// Bill은 보고서에서 billID의 집계를 찾습니다.
+func (r *Report) Bill(billID string) (BillTally, bool) {
+	for _, b := range r.Bills {
+		if b.BillID == billID {
+			return b, true
+		}
+	}
+	return BillTally{}, false
+}
+
+// Member는 보고서에서 code(MONA_CD)의 통계를 찾습니다.
+func (r *Report) Member(code string) (MemberStats, bool) {
+	for _, m := range r.Members {
+		if m.Code == code {
+			return m, true
+		}
+	}
+	return MemberStats{}, false
+}
+
+// Party는 보고서에서 정당 이름으로 통계를 찾습니다.
+func (r *Report) Party(name string) (PartyStats, bool) {
+	for _, p := range r.Parties {
+		if p.Party == name {
+			return p, true
+		}
+	}
+	return PartyStats{}, false
+}
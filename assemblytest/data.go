@@ -0,0 +1,118 @@
+package assemblytest
+
+import (
+	"assembly_go/models"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+)
+
+// AddBills는 TVBPMBILL11(법률안 심사 및 처리) 데이터를 추가합니다.
+func (s *Server) AddBills(rows ...models.TVBPMBILL11Row) { addRows(s, EndpointBills, rows) }
+
+// AddAllMembers는 ALLNAMEMBER(국회의원 정보 통합) 데이터를 추가합니다.
+func (s *Server) AddAllMembers(rows ...models.AllNameMemberRow) {
+	addRows(s, EndpointAllMembers, rows)
+}
+
+// AddBillConferences는 VCONFBILLCONFLIST(의안별 회의록 목록) 데이터를 추가합니다.
+func (s *Server) AddBillConferences(rows ...models.VCONFBILLCONFLISTRow) {
+	addRows(s, EndpointBillConferences, rows)
+}
+
+// AddConferences는 VCONFPHCONFLIST(회의록 통합) 데이터를 추가합니다.
+func (s *Server) AddConferences(rows ...models.VCONFPHCONFLISTRow) {
+	addRows(s, EndpointConferences, rows)
+}
+
+// AddVotes는 nojepdqqaweusdfbi(국회의원 본회의 표결정보) 데이터를 추가합니다.
+func (s *Server) AddVotes(rows ...models.NojepdqqaweusdfbiRow) { addRows(s, EndpointVotes, rows) }
+
+// AddHistoricalMembers는 nprlapfmaufmqytet(역대 국회의원 현황) 데이터를 추가합니다.
+func (s *Server) AddHistoricalMembers(rows ...models.NprlapfmaufmqytetRow) {
+	addRows(s, EndpointHistoricalMembers, rows)
+}
+
+// AddMemberDetails는 nwvrqwxyaytdsfvhu(국회의원 인적사항) 데이터를 추가합니다.
+func (s *Server) AddMemberDetails(rows ...models.NwvrqwxyaytdsfvhuRow) {
+	addRows(s, EndpointMemberDetails, rows)
+}
+
+// AddBillPDF는 filegate에서 bookId로 내려줄 법안 PDF를 등록합니다.
+// 같은 bookId로 다시 등록하면 내용과 ETag가 바뀝니다.
+func (s *Server) AddBillPDF(bookID string, data []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.files[fileGateKey(bookID)] = newFile(data)
+}
+
+// AddFile은 path(예: "/records/1.pdf")에서 내려줄 파일을 등록하고 그 절대 URL을 반환합니다.
+// 회의록 행의 DOWN_URL에 넣어 DownloadMeetingRecord를 시험할 때 사용합니다.
+func (s *Server) AddFile(path string, data []byte) string {
+	path = "/" + strings.TrimPrefix(path, "/")
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.files[path] = newFile(data)
+	return s.URL + path
+}
+
+func addRows[T any](s *Server, endpoint string, rows []T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, row := range rows {
+		s.rows[endpoint] = append(s.rows[endpoint], row)
+	}
+}
+
+// filterRows는 요청 인자 중 행의 JSON 필드 이름과 같은 인자를 일치 조건으로 적용합니다.
+// 값이 비어있는 인자와 행에 없는 필드 이름은 실제 포털처럼 무시합니다.
+func filterRows(rows []any, query url.Values) []any {
+	var matched []any
+	for _, row := range rows {
+		fields := rowFields(row)
+		ok := true
+		for name, values := range query {
+			if reservedParams[name] || len(values) == 0 || values[0] == "" {
+				continue
+			}
+			if v, exists := fields[name]; exists && v != values[0] {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			matched = append(matched, row)
+		}
+	}
+	return matched
+}
+
+// rowFields는 구조체 행을 JSON 필드 이름별 문자열 값으로 바꿉니다. nil 포인터는 빈 문자열입니다.
+func rowFields(row any) map[string]string {
+	fields := make(map[string]string)
+	v := reflect.ValueOf(row)
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		field := v.Field(i)
+		if field.Kind() == reflect.Ptr {
+			if field.IsNil() {
+				fields[name] = ""
+				continue
+			}
+			field = field.Elem()
+		}
+		switch value := field.Interface().(type) {
+		case json.Number:
+			fields[name] = value.String()
+		default:
+			fields[name] = fmt.Sprint(value)
+		}
+	}
+	return fields
+}
@@ -0,0 +1,78 @@
+package assemblytest
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Fault는 서버 응답에 주입할 장애입니다. 여러 항목을 함께 지정하면 지연이 먼저 적용됩니다.
+type Fault struct {
+	Path  string // 적용할 경로 ("TVBPMBILL11", "/filegate/sender30" 등). 비어있으면 모든 경로
+	Times int    // 적용할 횟수. 0이면 ClearFaults를 호출할 때까지 계속 적용
+
+	Latency    time.Duration // 응답 전 지연 시간
+	Status     int           // 이 HTTP 상태 코드(예: 503)와 짧은 텍스트 본문으로 응답
+	HTML       bool          // 200 상태의 HTML 오류 페이지로 응답
+	ResultCode string        // OpenAPI 요청에 이 RESULT 코드(예: ERROR-500)로 응답
+	TruncateAt int           // 파일 응답을 이 바이트 수에서 잘라 보냄 (Content-Length는 원래 길이)
+
+	used int
+}
+
+// InjectFault는 장애를 등록합니다. 경로가 일치하는 장애 중 먼저 등록된 것이 우선 적용됩니다.
+func (s *Server) InjectFault(f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if f.Path != "" {
+		f.Path = "/" + strings.TrimPrefix(f.Path, "/")
+	}
+	s.faults = append(s.faults, &f)
+}
+
+// ClearFaults는 등록된 장애를 모두 지웁니다.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = nil
+}
+
+// takeFault는 path에 적용할 장애를 찾아 사용 횟수를 늘리고 복사본을 반환합니다. s.mu를 잡은 상태에서 호출합니다.
+func (s *Server) takeFault(path string) *Fault {
+	for i, f := range s.faults {
+		if f.Path != "" && f.Path != path {
+			continue
+		}
+		f.used++
+		if f.Times > 0 && f.used >= f.Times {
+			s.faults = append(s.faults[:i], s.faults[i+1:]...)
+		}
+		copied := *f
+		return &copied
+	}
+	return nil
+}
+
+// apply는 응답 전체를 대신하는 장애(상태 코드, HTML 페이지)를 처리합니다.
+// 응답을 이미 썼으면 true를 반환합니다. ResultCode와 TruncateAt은 각 핸들러가 처리합니다.
+func (f *Fault) apply(w http.ResponseWriter, r *http.Request) bool {
+	if f.Latency > 0 {
+		select {
+		case <-time.After(f.Latency):
+		case <-r.Context().Done():
+			return true // 클라이언트가 먼저 포기했습니다.
+		}
+	}
+
+	switch {
+	case f.Status != 0:
+		http.Error(w, fmt.Sprintf("%d %s", f.Status, http.StatusText(f.Status)), f.Status)
+		return true
+	case f.HTML:
+		w.Header().Set("Content-Type", "text/html; charset=UTF-8")
+		fmt.Fprint(w, errorPage)
+		return true
+	}
+	return false
+}
@@ -0,0 +1,382 @@
+// Package assemblytest는 국회 OpenAPI를 흉내 내는 프로세스 내 테스트용 서버를 제공합니다.
+//
+// SDK가 다루는 모든 엔드포인트와 법안 PDF filegate(/filegate/sender30)를 구현하며,
+// 메모리에 넣어둔 데이터로 필터, 페이지 나누기, RESULT 오류 코드를 실제 포털과 같은 형식으로 응답합니다.
+// 지연, 5xx, HTML 오류 페이지, 잘린 PDF 같은 장애도 주입할 수 있습니다.
+//
+//	srv := assemblytest.NewServer()
+//	defer srv.Close()
+//	srv.AddBills(models.TVBPMBILL11Row{BillId: "PRC_...", Age: "22"})
+//	client, _ := srv.NewClient()
+package assemblytest
+
+import (
+	"assembly_go"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultAPIKey는 NewClient가 사용하는 인증키입니다. WithAPIKeys를 쓰지 않으면 모든 비어있지 않은 키를 허용합니다.
+const DefaultAPIKey = "ASSEMBLYTEST_KEY"
+
+// 서버가 구현하는 엔드포인트 이름입니다.
+const (
+	EndpointBills             = "TVBPMBILL11"
+	EndpointAllMembers        = "ALLNAMEMBER"
+	EndpointBillConferences   = "VCONFBILLCONFLIST"
+	EndpointConferences       = "VCONFPHCONFLIST"
+	EndpointVotes             = "nojepdqqaweusdfbi"
+	EndpointHistoricalMembers = "nprlapfmaufmqytet"
+	EndpointMemberDetails     = "nwvrqwxyaytdsfvhu"
+
+	// FileGatePath는 법안 PDF를 내려주는 filegate 경로입니다.
+	FileGatePath = "/filegate/sender30"
+)
+
+// requiredParams는 엔드포인트별 필수 요청 인자입니다. 빠지면 ERROR-300을 응답합니다.
+var requiredParams = map[string][]string{
+	EndpointBills:             nil,
+	EndpointAllMembers:        nil,
+	EndpointBillConferences:   {"BILL_ID"},
+	EndpointConferences:       {"ERACO"},
+	EndpointVotes:             {"AGE", "BILL_ID"},
+	EndpointHistoricalMembers: {"DAESU"},
+	EndpointMemberDetails:     nil,
+}
+
+// 페이지 크기 기본값과 최댓값입니다. 최댓값을 넘으면 ERROR-336을 응답합니다.
+const (
+	defaultPageSize = 10
+	maxPageSize     = 1000
+)
+
+// reservedParams는 필터로 취급하지 않는 공통 요청 인자입니다.
+var reservedParams = map[string]bool{"KEY": true, "Type": true, "pIndex": true, "pSize": true}
+
+// Server는 국회 OpenAPI를 흉내 내는 테스트용 HTTP 서버입니다.
+// 모든 메서드는 여러 고루틴에서 동시에 호출해도 안전합니다.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	rows     map[string][]any            // 엔드포인트별 데이터
+	files    map[string]file             // bookId 또는 경로별 PDF
+	keys     map[string]bool             // 허용하는 인증키 (nil이면 비어있지 않은 모든 키)
+	quotas   map[string]int              // 인증키별 남은 호출 수
+	faults   []*Fault                    // 주입된 장애
+	requests []Request                   // 받은 요청 기록
+	hooks    map[string]http.HandlerFunc // 경로별 사용자 정의 핸들러
+}
+
+// file은 서버가 내려주는 파일과 조건부 요청용 검증자입니다.
+type file struct {
+	data         []byte
+	etag         string
+	lastModified time.Time
+}
+
+// Request는 서버가 받은 요청의 기록입니다.
+type Request struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Header http.Header
+}
+
+// Option은 Server 설정을 위한 함수 타입입니다.
+type Option func(*Server)
+
+// WithAPIKeys는 서버가 허용할 인증키를 제한하는 옵션입니다. 목록에 없는 키는 ERROR-290을 받습니다.
+func WithAPIKeys(keys ...string) Option {
+	return func(s *Server) {
+		s.keys = make(map[string]bool)
+		for _, key := range keys {
+			s.keys[key] = true
+		}
+	}
+}
+
+// NewServer는 비어있는 데이터로 서버를 시작합니다. 사용이 끝나면 Close를 호출해야 합니다.
+func NewServer(options ...Option) *Server {
+	s := &Server{
+		rows:   make(map[string][]any),
+		files:  make(map[string]file),
+		quotas: make(map[string]int),
+		hooks:  make(map[string]http.HandlerFunc),
+	}
+	for _, opt := range options {
+		opt(s)
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// NewClient는 이 서버를 가리키는 SDK Client를 생성합니다.
+// options는 기본 설정(DefaultAPIKey, WithBaseURL) 뒤에 적용됩니다.
+func (s *Server) NewClient(options ...assembly_go.Option) (*assembly_go.Client, error) {
+	return assembly_go.NewClient(DefaultAPIKey, append([]assembly_go.Option{assembly_go.WithBaseURL(s.URL)}, options...)...)
+}
+
+// SetQuota는 key로 호출할 수 있는 남은 요청 수를 설정합니다.
+// 모두 사용한 뒤에는 ERROR-337(일별 트래픽 제한)을 응답합니다.
+func (s *Server) SetQuota(key string, remaining int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.quotas[key] = remaining
+}
+
+// Handle은 path에 대한 사용자 정의 핸들러를 등록합니다. 등록된 경로는 기본 동작보다 우선합니다.
+func (s *Server) Handle(path string, handler http.HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.hooks[path] = handler
+}
+
+// Requests는 지금까지 받은 요청 기록을 반환합니다.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// RequestCount는 path로 받은 요청 수를 반환합니다. path는 "TVBPMBILL11"처럼 앞의 '/' 없이 써도 됩니다.
+func (s *Server) RequestCount(path string) int {
+	path = "/" + strings.TrimPrefix(path, "/")
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	count := 0
+	for _, r := range s.requests {
+		if r.Path == path {
+			count++
+		}
+	}
+	return count
+}
+
+// Reset은 데이터, 파일, 장애, 할당량, 요청 기록을 모두 지웁니다.
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rows = make(map[string][]any)
+	s.files = make(map[string]file)
+	s.quotas = make(map[string]int)
+	s.faults = nil
+	s.requests = nil
+	s.hooks = make(map[string]http.HandlerFunc)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Query:  r.URL.Query(),
+		Header: r.Header.Clone(),
+	})
+	hook := s.hooks[r.URL.Path]
+	fault := s.takeFault(r.URL.Path)
+	s.mu.Unlock()
+
+	if fault != nil && fault.apply(w, r) {
+		return
+	}
+	if hook != nil {
+		hook(w, r)
+		return
+	}
+
+	endpoint := strings.TrimPrefix(r.URL.Path, "/")
+	switch {
+	case r.URL.Path == FileGatePath:
+		s.serveFileGate(w, r, fault)
+	case hasEndpoint(endpoint):
+		s.serveOpenAPI(w, r, endpoint, fault)
+	default:
+		s.serveFile(w, r, r.URL.Path, fault)
+	}
+}
+
+// hasEndpoint는 endpoint가 서버가 구현한 OpenAPI 엔드포인트인지 확인합니다.
+func hasEndpoint(endpoint string) bool {
+	_, ok := requiredParams[endpoint]
+	return ok
+}
+
+// serveOpenAPI는 OpenAPI JSON 응답을 만듭니다.
+func (s *Server) serveOpenAPI(w http.ResponseWriter, r *http.Request, endpoint string, fault *Fault) {
+	query := r.URL.Query()
+	if code := s.checkKey(query.Get("KEY")); code != "" {
+		writeResult(w, code)
+		return
+	}
+	if fault != nil && fault.ResultCode != "" {
+		writeResult(w, fault.ResultCode)
+		return
+	}
+
+	for _, name := range requiredParams[endpoint] {
+		if query.Get(name) == "" {
+			writeResult(w, assembly_go.ResultCodeMissingParam)
+			return
+		}
+	}
+
+	pageIndex, pageSize, ok := pageParams(query)
+	if !ok {
+		writeResult(w, assembly_go.ResultCodeMissingParam)
+		return
+	}
+	if pageSize > maxPageSize {
+		writeResult(w, assembly_go.ResultCodePageSize)
+		return
+	}
+
+	s.mu.Lock()
+	matched := filterRows(s.rows[endpoint], query)
+	s.mu.Unlock()
+
+	start := (pageIndex - 1) * pageSize
+	if len(matched) == 0 || start >= len(matched) {
+		writeResult(w, assembly_go.ResultCodeNoData)
+		return
+	}
+	end := min(start+pageSize, len(matched))
+
+	// 실제 포털과 같이 head와 row를 별도의 배열 요소로 보냅니다.
+	body := map[string]any{
+		endpoint: []any{
+			map[string]any{"head": []any{
+				map[string]any{"list_total_count": len(matched)},
+				map[string]any{"RESULT": resultBody(assembly_go.ResultCodeOK)},
+			}},
+			map[string]any{"row": matched[start:end]},
+		},
+	}
+	writeJSON(w, body)
+}
+
+// checkKey는 인증키와 남은 할당량을 확인해, 문제가 있으면 결과 코드를 반환합니다.
+func (s *Server) checkKey(key string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if key == "" || (s.keys != nil && !s.keys[key]) {
+		return assembly_go.ResultCodeInvalidKey
+	}
+	if remaining, ok := s.quotas[key]; ok {
+		if remaining <= 0 {
+			return assembly_go.ResultCodeDailyLimit
+		}
+		s.quotas[key] = remaining - 1
+	}
+	return ""
+}
+
+// pageParams는 pIndex, pSize를 해석합니다. 값이 없으면 기본값을 사용합니다.
+func pageParams(query url.Values) (pageIndex, pageSize int, ok bool) {
+	pageIndex, pageSize = 1, defaultPageSize
+	var err error
+	if v := query.Get("pIndex"); v != "" {
+		if pageIndex, err = strconv.Atoi(v); err != nil || pageIndex < 1 {
+			return 0, 0, false
+		}
+	}
+	if v := query.Get("pSize"); v != "" {
+		if pageSize, err = strconv.Atoi(v); err != nil || pageSize < 1 {
+			return 0, 0, false
+		}
+	}
+	return pageIndex, pageSize, true
+}
+
+// resultMessages는 결과 코드별 포털 메시지입니다.
+var resultMessages = map[string]string{
+	assembly_go.ResultCodeOK:           "정상 처리되었습니다.",
+	assembly_go.ResultCodeNoData:       "해당하는 데이터가 없습니다.",
+	assembly_go.ResultCodeQuotaLimit:   "유효 호출건수를 이미 초과하셨습니다.",
+	assembly_go.ResultCodeNoPermission: "권한이 없습니다. 관리자에게 문의하십시오.",
+	assembly_go.ResultCodeInvalidKey:   "인증키가 유효하지 않습니다.",
+	assembly_go.ResultCodeMissingParam: "필수 값이 누락되어 있습니다.",
+	assembly_go.ResultCodeNoService:    "해당하는 서비스를 찾을 수 없습니다.",
+	assembly_go.ResultCodePageSize:     "데이터요청은 한번에 최대 1,000건을 넘을 수 없습니다.",
+	assembly_go.ResultCodeDailyLimit:   "일별 트래픽 제한을 넘은 호출입니다.",
+	assembly_go.ResultCodeServerError:  "서버 오류입니다.",
+}
+
+func resultBody(code string) map[string]string {
+	return map[string]string{"CODE": code, "MESSAGE": resultMessages[code]}
+}
+
+// writeResult는 포털의 오류(또는 데이터 없음) 응답 형식인 {"RESULT":{...}}을 씁니다.
+func writeResult(w http.ResponseWriter, code string) {
+	writeJSON(w, map[string]any{"RESULT": resultBody(code)})
+}
+
+func writeJSON(w http.ResponseWriter, body any) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	json.NewEncoder(w).Encode(body)
+}
+
+// newFile은 내용으로부터 ETag를 계산해 file을 만듭니다.
+func newFile(data []byte) file {
+	sum := sha256.Sum256(data)
+	return file{
+		data:         append([]byte(nil), data...),
+		etag:         `"` + hex.EncodeToString(sum[:8]) + `"`,
+		lastModified: time.Now().UTC().Truncate(time.Second),
+	}
+}
+
+// errorPage는 filegate가 파일을 찾지 못했을 때 돌려주는 HTML 오류 페이지입니다.
+const errorPage = "<!DOCTYPE html>\n<html><head><title>오류</title></head><body>요청하신 파일을 찾을 수 없습니다.</body></html>"
+
+// serveFileGate는 bookId로 등록된 법안 PDF를 내려줍니다. 없으면 실제 게이트웨이처럼 200 상태의 HTML 오류 페이지를 보냅니다.
+func (s *Server) serveFileGate(w http.ResponseWriter, r *http.Request, fault *Fault) {
+	s.serveFile(w, r, fileGateKey(r.URL.Query().Get("bookId")), fault)
+}
+
+func fileGateKey(bookID string) string {
+	return "bookId:" + bookID
+}
+
+// serveFile은 key로 등록된 파일을 조건부 요청을 지원하며 내려줍니다.
+func (s *Server) serveFile(w http.ResponseWriter, r *http.Request, key string, fault *Fault) {
+	s.mu.Lock()
+	f, ok := s.files[key]
+	s.mu.Unlock()
+
+	if !ok {
+		w.Header().Set("Content-Type", "text/html; charset=UTF-8")
+		fmt.Fprint(w, errorPage)
+		return
+	}
+
+	if match := r.Header.Get("If-None-Match"); match != "" && match == f.etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	if since, err := http.ParseTime(r.Header.Get("If-Modified-Since")); err == nil && r.Header.Get("If-None-Match") == "" && !f.lastModified.After(since) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("ETag", f.etag)
+	w.Header().Set("Last-Modified", f.lastModified.Format(http.TimeFormat))
+	w.Header().Set("Content-Length", strconv.Itoa(len(f.data)))
+
+	data := f.data
+	if fault != nil && fault.TruncateAt > 0 && fault.TruncateAt < len(data) {
+		data = data[:fault.TruncateAt] // Content-Length보다 짧게 보내 연결이 중간에 끊긴 것처럼 만듭니다.
+	}
+	w.Write(data)
+}
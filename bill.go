@@ -0,0 +1,259 @@
+package assembly_go
+
+import (
+	"assembly_go/models"
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"strconv"
+	"strings"
+)
+
+// billPageSize는 GetBill이 회의록과 표결을 한 번에 가져오는 행 수입니다. 법안 하나의 표결은 의원 수(300명)를 넘지 않습니다.
+const billPageSize = 1000
+
+// VoteTally는 본회의 표결 결과별 의원 수입니다.
+type VoteTally struct {
+	Yes     int `json:"yes"`     // 찬성
+	No      int `json:"no"`      // 반대
+	Abstain int `json:"abstain"` // 기권
+	Absent  int `json:"absent"`  // 불참
+	Other   int `json:"other"`   // 그 밖의 값
+	Total   int `json:"total"`
+}
+
+// VoteChoice는 표결 결과(RESULT_VOTE_MOD)입니다.
+type VoteChoice uint8
+
+const (
+	VoteOther   VoteChoice = iota // 알 수 없는 값
+	VoteYes                       // 찬성
+	VoteNo                        // 반대
+	VoteAbstain                   // 기권
+	VoteAbsent                    // 불참
+)
+
+// ParseVoteChoice는 RESULT_VOTE_MOD 값을 VoteChoice로 바꿉니다. 앞뒤 공백은 무시합니다.
+func ParseVoteChoice(s string) VoteChoice {
+	switch strings.TrimSpace(s) {
+	case "찬성":
+		return VoteYes
+	case "반대":
+		return VoteNo
+	case "기권":
+		return VoteAbstain
+	case "불참":
+		return VoteAbsent
+	}
+	return VoteOther
+}
+
+// String은 RESULT_VOTE_MOD 표기를 반환합니다.
+func (c VoteChoice) String() string {
+	switch c {
+	case VoteYes:
+		return "찬성"
+	case VoteNo:
+		return "반대"
+	case VoteAbstain:
+		return "기권"
+	case VoteAbsent:
+		return "불참"
+	}
+	return "기타"
+}
+
+// Add는 표결 결과 c 하나를 t에 셉니다.
+func (t *VoteTally) Add(c VoteChoice) {
+	switch c {
+	case VoteYes:
+		t.Yes++
+	case VoteNo:
+		t.No++
+	case VoteAbstain:
+		t.Abstain++
+	case VoteAbsent:
+		t.Absent++
+	default:
+		t.Other++
+	}
+	t.Total++
+}
+
+// TallyVotes는 표결 행을 RESULT_VOTE_MOD 값별로 셉니다.
+func TallyVotes(votes []models.NojepdqqaweusdfbiRow) VoteTally {
+	var t VoteTally
+	for _, v := range votes {
+		t.Add(ParseVoteChoice(v.VoteResult))
+	}
+	return t
+}
+
+// Bill은 법안 하나의 메타데이터, 관련 회의록, 의원별 표결과 집계를 모은 결과입니다.
+type Bill struct {
+	Info     models.TVBPMBILL11Row         `json:"bill"`
+	Meetings []models.VCONFBILLCONFLISTRow `json:"meetings"`
+	Votes    []models.NojepdqqaweusdfbiRow `json:"votes"`
+	Tally    VoteTally                     `json:"tally"`
+	PDFURL   string                        `json:"pdf_url,omitempty"` // 원문 PDF 주소. Client로 조회한 경우에만 채워집니다.
+
+	MeetingsErr error `json:"-"` // 회의록 조회 실패
+	VotesErr    error `json:"-"` // 표결 조회 실패
+}
+
+// Partial은 회의록이나 표결 조회가 실패해 일부가 비어있는지 알려줍니다.
+func (b *Bill) Partial() bool {
+	return b.MeetingsErr != nil || b.VotesErr != nil
+}
+
+// BillService는 여러 OpenAPI를 조회해 Bill을 만듭니다. Fetcher로 Client나 localstore.Store를 받습니다.
+type BillService struct {
+	fetcher Fetcher
+}
+
+// NewBillService는 f로 조회하는 BillService를 생성합니다.
+func NewBillService(f Fetcher) *BillService {
+	return &BillService{fetcher: f}
+}
+
+// GetBill은 Client로 법안 하나를 조회합니다. NewBillService(c).GetBill과 같습니다.
+func (c *Client) GetBill(ctx context.Context, billID string) (*Bill, error) {
+	return NewBillService(c).GetBill(ctx, billID)
+}
+
+// GetBill은 billID의 메타데이터와 의안별 회의록을 동시에 조회하고, 메타데이터의 대수(AGE)로 표결을 조회해 Bill을 만듭니다.
+//
+// 메타데이터 조회가 실패하면 nil과 에러를, 법안이 없으면 ErrNotFound를 반환합니다. RESULT 오류 응답은 *APIError로 다룹니다.
+// 회의록이나 표결 조회만 실패하면 나머지를 채운 Bill과 ErrPartialResult를 감싼 에러를 함께 반환하며,
+// 실패한 부분은 Bill.MeetingsErr, Bill.VotesErr에 남습니다.
+// Client의 조회 메서드는 ctx를 받지 않으므로, ctx가 취소되면 진행 중인 요청을 기다리지 않고 ctx.Err()를 반환합니다.
+func (s *BillService) GetBill(ctx context.Context, billID string) (*Bill, error) {
+	if billID == "" {
+		return nil, ErrInvalidID
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	type meetingsResult struct {
+		rows []models.VCONFBILLCONFLISTRow
+		err  error
+	}
+	meetingsCh := make(chan meetingsResult, 1)
+	go func() {
+		rows, err := collect(Paginate(billPageSize, func(pIndex, pSize int) ([]models.VCONFBILLCONFLISTRow, int, error) {
+			resp, err := s.fetcher.FetchBillConferenceList(models.VCONFBILLCONFLISTRequestParams{Pindex: pIndex, Psize: pSize, BILL_ID: billID})
+			if err == nil {
+				err = CheckResult(resp)
+			}
+			if err != nil {
+				return nil, 0, err
+			}
+			return resp.AllRows(), resp.TotalCount(), nil
+		}))
+		meetingsCh <- meetingsResult{rows, err}
+	}()
+
+	type infoResult struct {
+		row models.TVBPMBILL11Row
+		err error
+	}
+	infoCh := make(chan infoResult, 1)
+	go func() {
+		resp, err := s.fetcher.FetchBillsWithOptions(models.TVBPMBILL11RequestParams{Pindex: "1", Psize: "1"}, models.TVBPMBILL11OptionalParams{BILL_ID: billID})
+		if err == nil {
+			err = CheckResult(resp)
+		}
+		if err != nil {
+			infoCh <- infoResult{err: err}
+			return
+		}
+		rows := resp.AllRows()
+		if len(rows) == 0 {
+			infoCh <- infoResult{err: fmt.Errorf("%w: bill %s", ErrNotFound, billID)}
+			return
+		}
+		infoCh <- infoResult{row: rows[0]}
+	}()
+
+	var info infoResult
+	select {
+	case info = <-infoCh:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if info.err != nil {
+		return nil, info.err
+	}
+
+	bill := &Bill{Info: info.row}
+	if p, ok := s.fetcher.(interface{ BillPDFURL(string) (string, error) }); ok {
+		bill.PDFURL, _ = p.BillPDFURL(billID)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	type votesResult struct {
+		rows []models.NojepdqqaweusdfbiRow
+		err  error
+	}
+	votesCh := make(chan votesResult, 1)
+	go func() {
+		rows, err := collect(Paginate(billPageSize, func(pIndex, pSize int) ([]models.NojepdqqaweusdfbiRow, int, error) {
+			resp, err := s.fetcher.FetchMemberVoteResult(models.NojepdqqaweusdfbiRequestParams{
+				Pindex:  strconv.Itoa(pIndex),
+				Psize:   strconv.Itoa(pSize),
+				AGE:     info.row.Age,
+				BILL_ID: billID,
+			})
+			if err == nil {
+				err = CheckResult(resp)
+			}
+			if err != nil {
+				return nil, 0, err
+			}
+			return resp.AllRows(), resp.TotalCount(), nil
+		}))
+		votesCh <- votesResult{rows, err}
+	}()
+
+	for range 2 {
+		select {
+		case m := <-meetingsCh:
+			bill.Meetings, bill.MeetingsErr = m.rows, m.err
+			meetingsCh = nil
+		case v := <-votesCh:
+			bill.Votes, bill.VotesErr = v.rows, v.err
+			votesCh = nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	bill.Tally = TallyVotes(bill.Votes)
+
+	if bill.Partial() {
+		var errs []error
+		if bill.MeetingsErr != nil {
+			errs = append(errs, fmt.Errorf("meetings: %w", bill.MeetingsErr))
+		}
+		if bill.VotesErr != nil {
+			errs = append(errs, fmt.Errorf("votes: %w", bill.VotesErr))
+		}
+		return bill, fmt.Errorf("%w: bill %s: %w", ErrPartialResult, billID, errors.Join(errs...))
+	}
+	return bill, nil
+}
+
+// collect는 seq의 값을 모읍니다. 에러가 나면 그때까지 모은 값과 에러를 반환합니다.
+func collect[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	var rows []T
+	for row, err := range seq {
+		if err != nil {
+			return rows, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
@@ -0,0 +1,310 @@
+// Package billdiff는 법률안(TVBPMBILL11) 행의 두 스냅숏을 의안ID로 맞춰 비교하고, 필드 단위 변경 기록을 만듭니다.
+//
+// 법안은 소관위(CMT_PROC_RESULT_CD), 법사위(LAW_PROC_RESULT_CD), 본회의(PROC_RESULT_CD) 단계를 거치지만
+// OpenAPI는 조회 시점의 상태만 알려줍니다. 매번 받은 목록을 이전 목록과 비교하면 단계별 결과가 언제 정해졌는지 알 수 있으며,
+// 변경 기록은 알림 문구(Change.String)나 JSON 감사 기록으로 사용할 수 있습니다.
+//
+//	d, _ := billdiff.New(billdiff.WithFields(billdiff.StatusFields...))
+//	for _, c := range d.Diff(yesterday, today) {
+//		fmt.Println(c) // [2200001] ○○법 일부개정법률안: 소관위 처리결과 수정가결 (2024-06-01)
+//	}
+package billdiff
+
+import (
+	"assembly_go"
+	"assembly_go/models"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Kind는 변경 기록의 종류입니다.
+type Kind string
+
+const (
+	BillAdded    Kind = "added"   // 새 스냅숏에 처음 나온 의안
+	BillRemoved  Kind = "removed" // 새 스냅숏에서 사라진 의안
+	FieldSet     Kind = "set"     // 비어있던 필드에 값이 생김
+	FieldChanged Kind = "changed" // 필드 값이 바뀜
+	FieldCleared Kind = "cleared" // 필드 값이 비워짐
+)
+
+// Stage는 필드가 속한 심사 단계입니다.
+type Stage string
+
+const (
+	StageCommittee Stage = "committee" // 소관위원회
+	StageJudiciary Stage = "judiciary" // 법제사법위원회
+	StagePlenary   Stage = "plenary"   // 본회의
+)
+
+// StatusFields는 단계별 처리 결과 필드입니다. 결과가 정해진 것만 알리려면 WithFields에 넘깁니다.
+var StatusFields = []string{"CMT_PROC_RESULT_CD", "LAW_PROC_RESULT_CD", "PROC_RESULT_CD"}
+
+// field는 비교할 필드 하나의 정보입니다.
+type field struct {
+	name  string // JSON 이름
+	label string
+	stage Stage
+	date  int // 이 결과에 대응하는 처리일 필드의 인덱스. 결과 필드가 아니면 -1
+	index int
+}
+
+// fields는 TVBPMBILL11Row의 필드를 구조체 순서대로 나열합니다. 의안ID는 비교 기준이므로 제외합니다.
+var fields = func() []field {
+	info := map[string]struct {
+		label string
+		stage Stage
+		date  string
+	}{
+		"BILL_NO":            {label: "의안번호"},
+		"AGE":                {label: "대수"},
+		"BILL_NAME":          {label: "의안명"},
+		"PROPOSER":           {label: "제안자"},
+		"PROPOSER_KIND":      {label: "제안자구분"},
+		"PROPOSE_DT":         {label: "제안일"},
+		"CURR_COMMITTEE_ID":  {label: "소관위코드", stage: StageCommittee},
+		"CURR_COMMITTEE":     {label: "소관위", stage: StageCommittee},
+		"COMMITTEE_DT":       {label: "소관위 회부일", stage: StageCommittee},
+		"COMMITTEE_PROC_DT":  {label: "위원회 심사 처리일", stage: StageCommittee},
+		"LINK_URL":           {label: "상세정보 URL"},
+		"RST_PROPOSER":       {label: "대표발의자"},
+		"LAW_PROC_RESULT_CD": {label: "법사위 처리결과", stage: StageJudiciary, date: "LAW_PROC_DT"},
+		"LAW_PROC_DT":        {label: "법사위 처리일", stage: StageJudiciary},
+		"LAW_PRESENT_DT":     {label: "법사위 상정일", stage: StageJudiciary},
+		"LAW_SUBMIT_DT":      {label: "법사위 회부일", stage: StageJudiciary},
+		"CMT_PROC_RESULT_CD": {label: "소관위 처리결과", stage: StageCommittee, date: "CMT_PROC_DT"},
+		"CMT_PROC_DT":        {label: "소관위 처리일", stage: StageCommittee},
+		"CMT_PRESENT_DT":     {label: "소관위 상정일", stage: StageCommittee},
+		"RST_MONA_CD":        {label: "대표발의자코드"},
+		"PROC_RESULT_CD":     {label: "본회의 심의결과", stage: StagePlenary, date: "PROC_DT"},
+		"PROC_DT":            {label: "의결일", stage: StagePlenary},
+	}
+
+	t := reflect.TypeOf(models.TVBPMBILL11Row{})
+	names := make([]string, t.NumField())
+	byName := make(map[string]int, len(names))
+	for i := range names {
+		names[i] = strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		byName[names[i]] = i
+	}
+
+	var list []field
+	for i, name := range names {
+		if name == "BILL_ID" {
+			continue
+		}
+		f := info[name]
+		label := f.label
+		if label == "" {
+			label = name
+		}
+		date := -1
+		if f.date != "" {
+			date = byName[f.date]
+		}
+		list = append(list, field{name: name, label: label, stage: f.stage, date: date, index: i})
+	}
+	return list
+}()
+
+// Change는 의안 하나의 변경 기록입니다. JSON으로 저장해 감사 기록으로 쓸 수 있습니다.
+type Change struct {
+	BillID     string    `json:"bill_id"`
+	BillNo     string    `json:"bill_no,omitempty"`
+	BillName   string    `json:"bill_name,omitempty"`
+	Kind       Kind      `json:"kind"`
+	Stage      Stage     `json:"stage,omitempty"`
+	Field      string    `json:"field,omitempty"` // 바뀐 필드의 JSON 이름 (예: "CMT_PROC_RESULT_CD")
+	Label      string    `json:"label,omitempty"` // 필드의 한글 이름 (예: "소관위 처리결과")
+	Old        string    `json:"old,omitempty"`
+	New        string    `json:"new,omitempty"`
+	Date       string    `json:"date,omitempty"` // 처리 결과 필드면 새 스냅숏의 해당 처리일
+	ObservedAt time.Time `json:"observed_at"`    // 변경을 발견한 시각
+}
+
+// String은 알림에 쓸 수 있는 한 줄 설명을 반환합니다.
+func (c Change) String() string {
+	subject := "[" + c.BillID + "]"
+	if c.BillNo != "" {
+		subject = "[" + c.BillNo + "]"
+	}
+	if c.BillName != "" {
+		subject += " " + c.BillName
+	}
+
+	var text string
+	switch c.Kind {
+	case BillAdded:
+		text = "새 의안"
+	case BillRemoved:
+		text = "목록에서 사라짐"
+	case FieldSet:
+		text = c.Label + " " + c.New
+	case FieldChanged:
+		text = c.Label + " " + c.Old + " → " + c.New
+	case FieldCleared:
+		text = c.Label + " 삭제 (이전: " + c.Old + ")"
+	}
+	if c.Date != "" && c.Kind != FieldCleared {
+		text += " (" + c.Date + ")"
+	}
+	return subject + ": " + text
+}
+
+// Option은 Differ 설정을 변경하는 함수 타입입니다.
+type Option func(*Differ)
+
+// WithFields는 비교할 필드를 JSON 이름으로 제한합니다. 기본값은 의안ID를 제외한 모든 필드입니다.
+func WithFields(names ...string) Option {
+	return func(d *Differ) {
+		d.names = names
+	}
+}
+
+// WithClock은 Change.ObservedAt에 쓸 현재 시각 함수를 설정합니다.
+func WithClock(now func() time.Time) Option {
+	return func(d *Differ) {
+		if now != nil {
+			d.now = now
+		}
+	}
+}
+
+// WithMembership은 의안이 새로 나오거나 사라진 것도 기록할지 설정합니다. 기본값은 true입니다.
+func WithMembership(enabled bool) Option {
+	return func(d *Differ) {
+		d.membership = enabled
+	}
+}
+
+// Differ는 두 스냅숏을 비교합니다.
+type Differ struct {
+	names      []string
+	fields     []field
+	now        func() time.Time
+	membership bool
+}
+
+// New는 Differ를 생성합니다. WithFields에 없는 필드 이름을 주면 에러를 반환합니다.
+func New(options ...Option) (*Differ, error) {
+	d := &Differ{now: time.Now, membership: true}
+	for _, opt := range options {
+		opt(d)
+	}
+	if len(d.names) == 0 {
+		d.fields = fields
+		return d, nil
+	}
+
+	wanted := make(map[string]bool, len(d.names))
+	for _, name := range d.names {
+		wanted[name] = true
+	}
+	for _, f := range fields {
+		if wanted[f.name] {
+			d.fields = append(d.fields, f)
+			delete(wanted, f.name)
+		}
+	}
+	for name := range wanted {
+		return nil, fmt.Errorf("%w: unknown bill field %q", assembly_go.ErrInvalidConfig, name)
+	}
+	return d, nil
+}
+
+// Diff는 기본 설정으로 old와 new를 비교합니다.
+func Diff(old, new []models.TVBPMBILL11Row) []Change {
+	d, _ := New()
+	return d.Diff(old, new)
+}
+
+// Diff는 old와 new를 의안ID로 맞춰 비교합니다. 결과는 new의 의안 순서(의안 안에서는 필드 순서)이며,
+// 사라진 의안은 old의 순서대로 마지막에 나옵니다. 같은 의안ID가 여러 번 나오면 마지막 행을 사용합니다.
+// nil 포인터와 빈 문자열은 같은 값으로 봅니다.
+func (d *Differ) Diff(old, new []models.TVBPMBILL11Row) []Change {
+	now := d.now()
+	before := index(old)
+	after := index(new)
+
+	var changes []Change
+	seen := make(map[string]bool, len(new))
+	for _, row := range new {
+		if row.BillId == "" || seen[row.BillId] {
+			continue
+		}
+		seen[row.BillId] = true
+		cur := after[row.BillId]
+		prev, ok := before[row.BillId]
+		if !ok {
+			if d.membership {
+				changes = append(changes, newChange(cur, BillAdded, now))
+			}
+			continue
+		}
+		changes = append(changes, d.compare(prev, cur, now)...)
+	}
+
+	if d.membership {
+		removed := make(map[string]bool)
+		for _, row := range old {
+			if _, ok := after[row.BillId]; ok || row.BillId == "" || removed[row.BillId] {
+				continue
+			}
+			removed[row.BillId] = true
+			changes = append(changes, newChange(before[row.BillId], BillRemoved, now))
+		}
+	}
+	return changes
+}
+
+// compare는 같은 의안의 두 행을 필드별로 비교합니다.
+func (d *Differ) compare(prev, cur models.TVBPMBILL11Row, now time.Time) []Change {
+	pv, cv := reflect.ValueOf(prev), reflect.ValueOf(cur)
+	var changes []Change
+	for _, f := range d.fields {
+		o, n := text(pv.Field(f.index)), text(cv.Field(f.index))
+		if o == n {
+			continue
+		}
+		c := newChange(cur, FieldChanged, now)
+		c.Stage, c.Field, c.Label, c.Old, c.New = f.stage, f.name, f.label, o, n
+		switch {
+		case o == "":
+			c.Kind = FieldSet
+		case n == "":
+			c.Kind = FieldCleared
+		}
+		if f.date >= 0 {
+			c.Date = text(cv.Field(f.date))
+		}
+		changes = append(changes, c)
+	}
+	return changes
+}
+
+func newChange(row models.TVBPMBILL11Row, kind Kind, now time.Time) Change {
+	return Change{BillID: row.BillId, BillNo: row.BillNumber, BillName: row.BillName, Kind: kind, ObservedAt: now}
+}
+
+func index(rows []models.TVBPMBILL11Row) map[string]models.TVBPMBILL11Row {
+	m := make(map[string]models.TVBPMBILL11Row, len(rows))
+	for _, row := range rows {
+		if row.BillId != "" {
+			m[row.BillId] = row
+		}
+	}
+	return m
+}
+
+// text는 문자열 필드나 문자열 포인터 필드의 값을 반환합니다. nil 포인터는 빈 문자열입니다.
+func text(v reflect.Value) string {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+	return strings.TrimSpace(v.String())
+}
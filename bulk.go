@@ -0,0 +1,406 @@
+package assembly_go
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DownloadJob은 BulkDownloader가 처리할 다운로드 작업 하나입니다. BillID와 URL 중 하나를 지정합니다.
+type DownloadJob struct {
+	BillID string // 법안 PDF를 filegate에서 받을 때의 의안 식별자 (DownloadBill과 같은 값)
+	URL    string // 회의록 DOWN_URL 등 PDF URL (DownloadMeetingRecord와 같은 값)
+	Path   string // 저장 디렉터리 기준 상대 경로. 비어있으면 식별자로 만듭니다.
+}
+
+// Key는 매니페스트에서 작업을 구분하는 식별자입니다. 법안은 "bill:<BillID>", 그 밖에는 URL입니다.
+func (j DownloadJob) Key() string {
+	if j.BillID != "" {
+		return "bill:" + j.BillID
+	}
+	return j.URL
+}
+
+// path는 작업을 저장할 상대 경로입니다.
+// Path가 없으면 법안은 "bills/<BillID>.pdf", URL은 "records/<URL 해시>.pdf"를 사용합니다.
+func (j DownloadJob) path() string {
+	if j.Path != "" {
+		return filepath.Clean(filepath.FromSlash(j.Path))
+	}
+	if j.BillID != "" {
+		return filepath.Join("bills", strings.NewReplacer("/", "_", `\`, "_").Replace(j.BillID)+".pdf")
+	}
+	sum := sha256.Sum256([]byte(j.URL))
+	return filepath.Join("records", hex.EncodeToString(sum[:8])+".pdf")
+}
+
+// BulkStatus는 작업 하나의 처리 결과입니다.
+type BulkStatus string
+
+const (
+	BulkDownloaded BulkStatus = "downloaded" // 새로 다운로드해 저장함
+	BulkSkipped    BulkStatus = "skipped"    // 매니페스트에 성공 기록이 있고 파일도 남아 있어 건너뜀
+	BulkDuplicate  BulkStatus = "duplicate"  // WithBulkDedup: 다운로드했지만 내용이 같은 파일이 이미 있어 따로 저장하지 않음
+	BulkFailed     BulkStatus = "failed"     // 다운로드나 저장에 실패함
+)
+
+// BulkResult는 작업 하나가 끝날 때마다 진행 상황 콜백에 전달됩니다.
+type BulkResult struct {
+	Job    DownloadJob
+	Status BulkStatus
+	Entry  ManifestEntry
+}
+
+// BulkSummary는 Run 한 번의 결과를 상태별로 센 값입니다.
+type BulkSummary struct {
+	Total      int
+	Downloaded int
+	Skipped    int
+	Duplicates int
+	Failed     int
+}
+
+// BulkOption은 BulkDownloader 설정을 변경하는 함수 타입입니다.
+type BulkOption func(*BulkDownloader)
+
+// WithBulkConcurrency는 동시에 다운로드할 작업 수를 설정합니다. 기본값은 4입니다.
+// 전체 요청 속도는 Client의 WithRateLimit 설정을 따릅니다.
+func WithBulkConcurrency(n int) BulkOption {
+	return func(b *BulkDownloader) {
+		if n > 0 {
+			b.concurrency = n
+		}
+	}
+}
+
+// WithBulkManifest는 매니페스트 파일 경로를 설정합니다. 기본값은 저장 디렉터리의 manifest.json입니다.
+func WithBulkManifest(path string) BulkOption {
+	return func(b *BulkDownloader) {
+		b.manifestPath = path
+	}
+}
+
+// WithBulkRedownload는 매니페스트에 성공 기록이 있어도 모든 작업을 다시 다운로드하게 합니다.
+func WithBulkRedownload() BulkOption {
+	return func(b *BulkDownloader) {
+		b.redownload = true
+	}
+}
+
+// WithBulkAdoptExisting은 매니페스트에 기록이 없더라도 저장할 경로에 파일이 이미 있으면
+// 다운로드하지 않고 그 파일을 해시해 기록하게 합니다. 매니페스트 없이 모아둔 기존 보관소를 이어받을 때 사용합니다.
+func WithBulkAdoptExisting() BulkOption {
+	return func(b *BulkDownloader) {
+		b.adoptExisting = true
+	}
+}
+
+// WithBulkDedup은 내용(SHA-256)이 이미 받은 파일과 같으면 새로 저장하지 않고, 기록의 Path와 DuplicateOf가
+// 원본을 가리키게 합니다. 이 경우 작업이 요청한 경로에는 파일이 생기지 않으므로, 경로 배치가 중요하면 쓰지 마십시오.
+func WithBulkDedup() BulkOption {
+	return func(b *BulkDownloader) {
+		b.dedup = true
+	}
+}
+
+// WithBulkProgress는 작업이 끝날 때마다 호출할 함수를 설정합니다. 여러 고루틴에서 동시에 호출되지 않습니다.
+func WithBulkProgress(fn func(BulkResult)) BulkOption {
+	return func(b *BulkDownloader) {
+		b.progress = fn
+	}
+}
+
+// manifestFlushEvery는 매니페스트를 파일에 반영하는 주기(완료된 작업 수)입니다.
+// 매번 전체를 다시 쓰면 작업이 많을 때 비용이 크므로 모아서 저장하고, Run이 끝날 때 한 번 더 저장합니다.
+const manifestFlushEvery = 32
+
+// BulkDownloader는 법안·회의록 PDF를 동시에 여러 개 내려받아 디렉터리에 저장하고,
+// 결과를 매니페스트(JSON)에 기록하는 일괄 다운로드 관리자입니다.
+//
+// 중단된 뒤 같은 매니페스트로 다시 실행하면 성공한 작업은 건너뛰고 실패했거나 남은 작업만 처리합니다.
+// 각 작업은 요청한 경로에 저장합니다. WithBulkDedup을 주면 내용(SHA-256)이 같은 파일은 한 번만 저장합니다.
+type BulkDownloader struct {
+	client        *Client
+	dir           string
+	manifestPath  string
+	manifest      *Manifest
+	concurrency   int
+	redownload    bool
+	adoptExisting bool
+	dedup         bool
+	progress      func(BulkResult)
+
+	storeMu  sync.Mutex // 해시 중복 확인(WithBulkDedup)과 파일 저장을 묶어 같은 내용이 두 번 저장되지 않게 합니다.
+	mu       sync.Mutex // 아래 필드와 progress 호출 보호
+	inflight map[string]bool
+	unsaved  int
+}
+
+// NewBulkDownloader는 client로 내려받아 dir에 저장하는 BulkDownloader를 생성합니다.
+// 매니페스트 파일이 있으면 읽어서 이어서 진행합니다.
+func NewBulkDownloader(client *Client, dir string, options ...BulkOption) (*BulkDownloader, error) {
+	if client == nil {
+		return nil, fmt.Errorf("%w: client is nil", ErrInvalidConfig)
+	}
+	b := &BulkDownloader{
+		client:      client,
+		dir:         dir,
+		concurrency: 4,
+		inflight:    make(map[string]bool),
+	}
+	for _, opt := range options {
+		opt(b)
+	}
+	if b.manifestPath == "" {
+		b.manifestPath = filepath.Join(dir, "manifest.json")
+	}
+
+	manifest, err := LoadManifest(b.manifestPath)
+	if err != nil {
+		return nil, err
+	}
+	b.manifest = manifest
+	return b, nil
+}
+
+// Manifest는 BulkDownloader의 매니페스트를 반환합니다.
+func (b *BulkDownloader) Manifest() *Manifest {
+	return b.manifest
+}
+
+// RunJobs는 jobs를 모두 처리합니다. Run의 편의 함수입니다.
+func (b *BulkDownloader) RunJobs(ctx context.Context, jobs []DownloadJob) (BulkSummary, error) {
+	ch := make(chan DownloadJob)
+	go func() {
+		defer close(ch)
+		for _, job := range jobs {
+			select {
+			case ch <- job:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return b.Run(ctx, ch)
+}
+
+// Run은 jobs 채널이 닫힐 때까지 작업을 받아 처리하고, 끝나면 매니페스트를 저장합니다.
+// ctx가 취소되면 새 작업을 더 받지 않고, 진행 중인 다운로드가 끝나기를 기다린 뒤 ctx.Err()를 반환합니다.
+// 이때 jobs에 보내는 쪽도 ctx를 확인해야 채널 전송에서 멈추지 않습니다.
+// 개별 작업의 실패는 에러로 반환하지 않고 매니페스트와 BulkSummary.Failed에 기록합니다.
+func (b *BulkDownloader) Run(ctx context.Context, jobs <-chan DownloadJob) (BulkSummary, error) {
+	var (
+		summary   BulkSummary
+		summaryMu sync.Mutex
+		saveErr   error
+		wg        sync.WaitGroup
+	)
+	for range b.concurrency {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				var job DownloadJob
+				var ok bool
+				select {
+				case <-ctx.Done():
+					return
+				case job, ok = <-jobs:
+					if !ok {
+						return
+					}
+				}
+
+				result := b.process(job)
+				summaryMu.Lock()
+				summary.Total++
+				switch result.Status {
+				case BulkDownloaded:
+					summary.Downloaded++
+				case BulkSkipped:
+					summary.Skipped++
+				case BulkDuplicate:
+					summary.Duplicates++
+				case BulkFailed:
+					summary.Failed++
+				}
+				summaryMu.Unlock()
+
+				if err := b.finish(result); err != nil {
+					summaryMu.Lock()
+					saveErr = err
+					summaryMu.Unlock()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if err := b.manifest.Save(); err != nil {
+		return summary, err
+	}
+	if saveErr != nil {
+		return summary, saveErr
+	}
+	return summary, ctx.Err()
+}
+
+// finish는 진행 상황 콜백을 호출하고, 완료된 작업이 쌓이면 매니페스트를 저장합니다.
+func (b *BulkDownloader) finish(result BulkResult) error {
+	b.mu.Lock()
+	if b.progress != nil {
+		b.progress(result)
+	}
+	if result.Status != BulkSkipped {
+		b.unsaved++
+	}
+	flush := b.unsaved >= manifestFlushEvery
+	if flush {
+		b.unsaved = 0
+	}
+	b.mu.Unlock()
+
+	if flush {
+		return b.manifest.Save()
+	}
+	return nil
+}
+
+// process는 작업 하나를 처리하고 그 결과를 매니페스트에 기록합니다.
+func (b *BulkDownloader) process(job DownloadJob) BulkResult {
+	key := job.Key()
+	entry := ManifestEntry{Key: key, BillID: job.BillID, URL: job.URL}
+	fail := func(err error) BulkResult {
+		entry.Error = err.Error()
+		entry.FetchedAt = time.Now()
+		b.manifest.Put(entry)
+		return BulkResult{Job: job, Status: BulkFailed, Entry: entry}
+	}
+
+	if key == "" {
+		return BulkResult{Job: job, Status: BulkFailed, Entry: ManifestEntry{Error: ErrInvalidID.Error()}}
+	}
+	path := job.path()
+	if !filepath.IsLocal(path) {
+		return fail(fmt.Errorf("%w: path %q escapes download directory", ErrInvalidConfig, job.Path))
+	}
+	entry.Path = filepath.ToSlash(path)
+
+	// 같은 작업이 동시에 두 번 들어오면 하나만 처리합니다.
+	if !b.claim(key) {
+		prev, _ := b.manifest.Get(key)
+		return BulkResult{Job: job, Status: BulkSkipped, Entry: prev}
+	}
+	defer b.release(key)
+
+	if !b.redownload {
+		if prev, ok := b.manifest.Get(key); ok && prev.OK() && b.exists(prev.Path) {
+			return BulkResult{Job: job, Status: BulkSkipped, Entry: prev}
+		}
+		if b.adoptExisting {
+			if data, err := os.ReadFile(b.abs(entry.Path)); err == nil && len(data) > 0 {
+				entry = describe(entry, data)
+				b.manifest.Put(entry)
+				return BulkResult{Job: job, Status: BulkSkipped, Entry: entry}
+			}
+		}
+	}
+
+	var data []byte
+	var err error
+	if job.BillID != "" {
+		data, err = b.client.DownloadBill(job.BillID)
+	} else {
+		data, err = b.client.DownloadMeetingRecord(job.URL)
+	}
+	if err != nil {
+		return fail(err)
+	}
+	entry = describe(entry, data)
+
+	b.storeMu.Lock()
+	defer b.storeMu.Unlock()
+	if b.dedup {
+		if orig, ok := b.manifest.findSHA256(entry.SHA256); ok && orig.Key != key && b.exists(orig.Path) {
+			entry.Path = orig.Path
+			entry.DuplicateOf = orig.Key
+			b.manifest.Put(entry)
+			return BulkResult{Job: job, Status: BulkDuplicate, Entry: entry}
+		}
+	}
+	if err := writeFileAtomic(b.abs(entry.Path), data); err != nil {
+		return fail(err)
+	}
+	b.manifest.Put(entry)
+	return BulkResult{Job: job, Status: BulkDownloaded, Entry: entry}
+}
+
+// describe는 data의 크기, 해시, 콘텐츠 타입과 완료 시각을 entry에 채웁니다.
+func describe(entry ManifestEntry, data []byte) ManifestEntry {
+	sum := sha256.Sum256(data)
+	entry.Size = int64(len(data))
+	entry.SHA256 = hex.EncodeToString(sum[:])
+	entry.ContentType = http.DetectContentType(data)
+	entry.FetchedAt = time.Now()
+	entry.Error = ""
+	entry.DuplicateOf = ""
+	return entry
+}
+
+func (b *BulkDownloader) claim(key string) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.inflight[key] {
+		return false
+	}
+	b.inflight[key] = true
+	return true
+}
+
+func (b *BulkDownloader) release(key string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.inflight, key)
+}
+
+// abs는 매니페스트의 상대 경로를 저장 디렉터리 기준 경로로 바꿉니다.
+func (b *BulkDownloader) abs(path string) string {
+	return filepath.Join(b.dir, filepath.FromSlash(path))
+}
+
+// exists는 매니페스트의 상대 경로에 비어있지 않은 파일이 있는지 확인합니다.
+func (b *BulkDownloader) exists(path string) bool {
+	info, err := os.Stat(b.abs(path))
+	return err == nil && info.Mode().IsRegular() && info.Size() > 0
+}
+
+// writeFileAtomic은 같은 디렉터리의 임시 파일에 쓴 뒤 path로 이름을 바꿉니다.
+// 중간에 중단되어도 path에 일부만 쓰인 파일이 남지 않습니다.
+func writeFileAtomic(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*.part")
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,237 @@
+// Package cassette는 국회 OpenAPI 트래픽을 파일로 녹화하고 재생하는 http.RoundTripper를 제공합니다.
+//
+// 실제 포털에 한 번 요청해 응답을 카세트 디렉터리에 저장해 두면, CI에서는 네트워크 없이 같은 응답을 재생할 수 있습니다.
+// 녹화할 때 인증키(KEY)는 저장하지 않으며, 요청은 엔드포인트 경로와 정규화한 쿼리로 매칭합니다.
+//
+//	rec := cassette.NewRecorder("testdata/cassettes", nil)
+//	client, _ := assembly_go.NewClient(key, assembly_go.WithHTTPClient(&http.Client{Transport: rec}))
+//
+//	rep, _ := cassette.NewReplayer("testdata/cassettes")
+//	client, _ := assembly_go.NewClient("any", assembly_go.WithHTTPClient(&http.Client{Transport: rep}))
+package cassette
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// ErrNoMatch는 재생 모드에서 카세트에 없는 요청을 받았을 때 반환됩니다.
+var ErrNoMatch = errors.New("cassette: no recorded interaction matches request")
+
+// scrubbedParams는 녹화 파일에 저장하지 않고 매칭에도 사용하지 않는 쿼리 인자입니다.
+var scrubbedParams = map[string]bool{"KEY": true}
+
+// Interaction은 녹화된 요청과 응답 한 쌍입니다. 카세트 디렉터리에 JSON 파일 하나로 저장됩니다.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest는 녹화된 요청입니다. Query에는 KEY가 제거되어 있습니다.
+type RecordedRequest struct {
+	Method string     `json:"method"`
+	Host   string     `json:"host"`
+	Path   string     `json:"path"`
+	Query  url.Values `json:"query"`
+}
+
+// RecordedResponse는 녹화된 응답입니다. 본문이 UTF-8 텍스트가 아니면 Body 대신 BodyBase64를 사용합니다.
+type RecordedResponse struct {
+	Status     int         `json:"status"`
+	Header     http.Header `json:"header"`
+	Body       string      `json:"body,omitempty"`
+	BodyBase64 []byte      `json:"body_base64,omitempty"`
+}
+
+// MatchKey는 요청을 매칭할 때 쓰는 문자열입니다. 메서드, 경로, 정렬된 쿼리(KEY 제외)로 구성됩니다.
+// 같은 엔드포인트에 인자 순서만 다른 요청은 같은 키를 가집니다.
+func MatchKey(method string, u *url.URL) string {
+	return method + " " + u.Path + "?" + normalizeQuery(u.Query()).Encode()
+}
+
+// normalizeQuery는 KEY를 제거하고 빈 값을 뺀 쿼리를 반환합니다. url.Values.Encode는 키 순으로 정렬합니다.
+func normalizeQuery(query url.Values) url.Values {
+	normalized := make(url.Values)
+	for name, values := range query {
+		if scrubbedParams[name] {
+			continue
+		}
+		for _, v := range values {
+			if v != "" {
+				normalized.Add(name, v)
+			}
+		}
+		if vs := normalized[name]; len(vs) > 1 {
+			sort.Strings(vs)
+		}
+	}
+	return normalized
+}
+
+// fileName은 매칭 키로부터 카세트 파일 이름을 만듭니다.
+// 사람이 알아볼 수 있도록 경로 마지막 부분을 앞에 붙이고, 충돌을 피하기 위해 해시를 덧붙입니다.
+func fileName(key string, u *url.URL) string {
+	sum := sha256.Sum256([]byte(key))
+	base := strings.Trim(filepath.Base(u.Path), "./")
+	if base == "" {
+		base = "root"
+	}
+	return base + "-" + hex.EncodeToString(sum[:8]) + ".json"
+}
+
+// Recorder는 실제 요청을 보내고, 요청과 응답을 카세트 디렉터리에 저장하는 http.RoundTripper입니다.
+type Recorder struct {
+	dir  string
+	next http.RoundTripper
+	mu   sync.Mutex
+}
+
+// NewRecorder는 dir에 녹화하는 Recorder를 생성합니다. next가 nil이면 http.DefaultTransport로 요청을 보냅니다.
+// 같은 요청을 다시 녹화하면 기존 파일을 덮어씁니다.
+func NewRecorder(dir string, next http.RoundTripper) *Recorder {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &Recorder{dir: dir, next: next}
+}
+
+// RoundTrip은 요청을 보낸 뒤 응답을 저장하고, 본문을 다시 읽을 수 있는 응답을 반환합니다.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := r.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("cassette: failed to read response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	interaction := Interaction{
+		Request: RecordedRequest{
+			Method: req.Method,
+			Host:   req.URL.Host,
+			Path:   req.URL.Path,
+			Query:  normalizeQuery(req.URL.Query()),
+		},
+		Response: RecordedResponse{Status: resp.StatusCode, Header: resp.Header.Clone()},
+	}
+	if isText(body) {
+		interaction.Response.Body = string(body)
+	} else {
+		interaction.Response.BodyBase64 = body
+	}
+
+	if err := r.save(fileName(MatchKey(req.Method, req.URL), req.URL), interaction); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (r *Recorder) save(name string, interaction Interaction) error {
+	data, err := json.MarshalIndent(interaction, "", "  ")
+	if err != nil {
+		return fmt.Errorf("cassette: failed to encode interaction: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := os.MkdirAll(r.dir, 0o755); err != nil {
+		return fmt.Errorf("cassette: failed to create directory: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(r.dir, name), data, 0o644); err != nil {
+		return fmt.Errorf("cassette: failed to write interaction: %w", err)
+	}
+	return nil
+}
+
+// isText는 본문을 JSON 문자열로 그대로 저장해도 되는지 확인합니다. PDF 등 바이너리는 base64로 저장합니다.
+func isText(body []byte) bool {
+	return utf8.Valid(body) && !bytes.HasPrefix(body, []byte("%PDF")) && bytes.IndexByte(body, 0) < 0
+}
+
+// Replayer는 카세트 디렉터리에 녹화된 응답만 돌려주는 http.RoundTripper입니다.
+// 녹화되지 않은 요청에는 네트워크로 나가지 않고 ErrNoMatch를 감싼 에러를 반환합니다.
+type Replayer struct {
+	interactions map[string]Interaction
+	mu           sync.Mutex
+	misses       []string
+}
+
+// NewReplayer는 dir의 모든 카세트 파일을 읽어 Replayer를 생성합니다.
+func NewReplayer(dir string) (*Replayer, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, fmt.Errorf("cassette: %w", err)
+	}
+
+	r := &Replayer{interactions: make(map[string]Interaction)}
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("cassette: %w", err)
+		}
+		var interaction Interaction
+		if err := json.Unmarshal(data, &interaction); err != nil {
+			return nil, fmt.Errorf("cassette: failed to parse %s: %w", path, err)
+		}
+		u := &url.URL{Path: interaction.Request.Path, RawQuery: interaction.Request.Query.Encode()}
+		r.interactions[MatchKey(interaction.Request.Method, u)] = interaction
+	}
+	return r, nil
+}
+
+// Len은 읽어 들인 녹화 수를 반환합니다.
+func (r *Replayer) Len() int {
+	return len(r.interactions)
+}
+
+// Misses는 매칭에 실패한 요청의 매칭 키 목록을 반환합니다. 테스트 종료 시 비어있는지 확인할 수 있습니다.
+func (r *Replayer) Misses() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.misses...)
+}
+
+// RoundTrip은 녹화된 응답을 돌려줍니다.
+func (r *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	key := MatchKey(req.Method, req.URL)
+	interaction, ok := r.interactions[key]
+	if !ok {
+		r.mu.Lock()
+		r.misses = append(r.misses, key)
+		r.mu.Unlock()
+		return nil, fmt.Errorf("%w: %s (recorded: %d interactions)", ErrNoMatch, key, len(r.interactions))
+	}
+
+	body := interaction.Response.BodyBase64
+	if body == nil {
+		body = []byte(interaction.Response.Body)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", interaction.Response.Status, http.StatusText(interaction.Response.Status)),
+		StatusCode:    interaction.Response.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        interaction.Response.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
@@ -2,14 +2,16 @@ package assembly_go
 
 import (
 	"assembly_go/models"
+	"assembly_go/terms"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"reflect" // StructToMapString에 필요
+	"slices"
 	"strconv" // StructToMapString에 필요
+	"strings"
 	"time"
 )
 
@@ -30,29 +32,41 @@ type Downloader interface {
 	FetchMemberDetails(params models.NwvrqwxyaytdsfvhuRequestParams) (*models.NwvrqwxyaytdsfvhuResponse, error)
 }
 
+// ConditionalDownloader는 이전에 받은 뒤로 바뀐 경우에만 PDF를 내려받는 조건부 다운로드 기능입니다.
+// Downloader에 넣으면 기존 구현체가 모두 깨지므로 따로 둡니다. Client는 둘 다 구현합니다.
+type ConditionalDownloader interface {
+	DownloadBillIfModified(innerBillId string) (*DownloadResult, error)
+	DownloadMeetingRecordIfModified(pdfURL string) (*DownloadResult, error)
+}
+
 // Client는 Downloader 인터페이스의 구현체입니다.
 // 실제 로직을 수행하는 주체이며, HTTP 클라이언트나 재시도 횟수 같은 내부 상태를 가집니다.
 type Client struct {
-	httpClient *http.Client
-	baseURL    string
-	retries    int
-	apiKey     string // API Key를 Client 구조체에 포함시킵니다.
+	httpClient     *http.Client // OpenAPI 요청용
+	downloadClient *http.Client // PDF 다운로드용 (Transport는 httpClient와 공유)
+	http           httpSettings // 옵션으로 받은 HTTP 설정 (NewClient에서 위 두 클라이언트를 만듭니다)
+	baseURL        string
+	retries        int
+	keys           KeyProvider    // 요청마다 사용할 API Key를 공급합니다.
+	validators     ValidatorStore // 조건부 다운로드에 사용할 ETag / Last-Modified 저장소
+
+	fileBaseURL string       // 법안 PDF filegate 기본 URL (비어있으면 baseURL 사용)
+	userAgent   string       // 비어있으면 DefaultUserAgent 사용
+	browserUA   bool         // 파일 게이트웨이 요청에 브라우저 User-Agent 사용 여부
+	headers     http.Header  // 모든 요청에 추가할 기본 헤더
+	limiter     *rateLimiter // nil이면 속도 제한 없음
+	rawTerms    bool         // true면 AGE, DAESU, ERACO 인자를 바꾸지 않고 그대로 보냄
 }
 
 // NewClient는 새로운 SDK 클라이언트를 생성합니다.
-// apiKey가 비어있는 경우 ErrInvalidID 에러를 반환합니다.
+// WithAPIKeys 또는 WithKeyProvider 옵션이 주어지면 apiKey 인자는 무시되므로 빈 문자열을 전달할 수 있습니다.
+// 사용할 API Key가 하나도 없으면 ErrNoAPIKey 에러를 반환합니다.
 func NewClient(apiKey string, options ...Option) (*Client, error) { // 반환값에 error 추가
-	if apiKey == "" {
-		return nil, errors.New("API Key is required") // 명시적인 에러 메시지
-		// 또는 정의된 SDK 에러를 사용: return nil, ErrInvalidID
-	}
-
 	// 기본값 설정
 	c := &Client{
-		httpClient: &http.Client{Timeout: 30 * time.Second},      // 기본 타임아웃 30초
 		baseURL:    "https://open.assembly.go.kr/portal/openapi", // OpenAPI 기본 URL
 		retries:    3,
-		apiKey:     apiKey, // API Key 설정
+		validators: NewMemoryValidatorStore(),
 	}
 
 	// 사용자가 제공한 옵션으로 기본값 덮어쓰기
@@ -60,9 +74,29 @@ func NewClient(apiKey string, options ...Option) (*Client, error) { // 반환값
 		opt(c)
 	}
 
+	// 모든 옵션을 적용한 뒤 HTTP 클라이언트를 만들어 옵션 순서에 영향을 받지 않도록 합니다.
+	var err error
+	c.httpClient, c.downloadClient, err = c.http.buildHTTPClients() // 기본 타임아웃 30초
+	if err != nil {
+		return nil, err
+	}
+
+	if c.keys == nil {
+		if apiKey == "" {
+			return nil, fmt.Errorf("%w: API Key is required", ErrNoAPIKey) // 명시적인 에러 메시지
+		}
+		c.keys = NewRotatingKeyProvider(apiKey) // API Key 설정
+	}
+
 	return c, nil // 에러가 없으면 nil 반환
 }
 
+// KeyProvider는 Client가 사용하는 인증키 공급자를 반환합니다.
+// WithAPIKeys로 만든 공급자는 *RotatingKeyProvider이므로 Usage로 키별 사용량을 확인할 수 있습니다.
+func (c *Client) KeyProvider() KeyProvider {
+	return c.keys
+}
+
 // --- 공개 API 메서드 구현 ---
 // 이 코드를 client.go에 추가하거나 유지합니다.
 
@@ -76,6 +110,17 @@ func (c *Client) DownloadMeetingRecord(pdfURL string) ([]byte, error) {
 	return c.downloadPdfWithUrl(pdfURL)
 }
 
+// DownloadBillIfModified는 이전에 받은 뒤로 법안 PDF가 변경된 경우에만 내용을 다운로드합니다.
+// 변경이 없으면 NotModified가 true인 결과를 반환하므로, 호출자는 파일 재작성을 건너뛸 수 있습니다.
+func (c *Client) DownloadBillIfModified(innerBillId string) (*DownloadResult, error) {
+	return c.downloadBillPdfIfModified(innerBillId)
+}
+
+// DownloadMeetingRecordIfModified는 이전에 받은 뒤로 회의록 PDF가 변경된 경우에만 내용을 다운로드합니다.
+func (c *Client) DownloadMeetingRecordIfModified(pdfURL string) (*DownloadResult, error) {
+	return c.downloadPdfWithUrlIfModified(pdfURL)
+}
+
 // 이 아래에 인터페이스 메소드들을 구현해 나갈 것입니다.
 // 기존 DownloadBill 및 DownloadMeetingRecord 메서드는 그대로 유지하거나,
 // Client 구조체의 메서드로 옮겨와 Client의 httpClient를 사용하도록 변경할 수 있습니다.
@@ -85,7 +130,22 @@ func (c *Client) DownloadMeetingRecord(pdfURL string) ([]byte, error) {
 // download는 실제 HTTP GET 요청 및 재시도를 처리하는 내부 헬퍼 함수입니다.
 // 기존 leginote/assembly-go/common.go 에서 가져와 Client의 메서드로 변경합니다.
 func (c *Client) download(req *http.Request) ([]byte, error) {
-	var body []byte
+	resp, err := c.doWithRetry(req, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrDownloadFailed, err)
+	}
+	return body, nil // 성공 시 즉시 반환
+}
+
+// doWithRetry는 응답 상태 코드가 accept 중 하나가 될 때까지 요청을 재시도합니다.
+// 성공 시 반환된 응답의 Body는 호출자가 닫아야 합니다.
+func (c *Client) doWithRetry(req *http.Request, accept ...int) (*http.Response, error) {
 	var err error
 	var resp *http.Response
 
@@ -94,58 +154,95 @@ func (c *Client) download(req *http.Request) ([]byte, error) {
 			time.Sleep(2 * time.Second) // 재시도 전 2초 대기
 		}
 
-		resp, err = c.httpClient.Do(req)
+		resp, err = c.do(c.downloadClient, req)
 		if err != nil {
 			continue // 요청 자체에 실패하면 재시도
 		}
 
-		if resp.StatusCode == http.StatusOK {
-			body, err = io.ReadAll(resp.Body)
-			resp.Body.Close()
-			if err != nil {
-				return nil, fmt.Errorf("%w: %v", ErrDownloadFailed, err)
-			}
-			return body, nil // 성공 시 즉시 반환
+		if slices.Contains(accept, resp.StatusCode) {
+			return resp, nil
 		}
 		resp.Body.Close()
 	}
 
 	// 모든 재시도 실패 시 마지막 응답과 에러를 기반으로 에러 반환
 	if err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrDownloadFailed, err)
+		return nil, fmt.Errorf("%w: %w", ErrDownloadFailed, err)
+	}
+	if resp == nil {
+		return nil, fmt.Errorf("%w: no request attempted (retries: %d)", ErrDownloadFailed, c.retries)
 	}
 	return nil, fmt.Errorf("%w: received status code %d", ErrDownloadFailed, resp.StatusCode)
 }
 
 // FetchApiData 함수는 URL, 헤더, 메서드, 요청 인자를 받아 HTTP 요청을 보내고 결과를 반환합니다.
 // 이 함수는 leginote-worker-bill/api/assembly/common.go 에서 가져와 SDK 내부 헬퍼 함수로 사용합니다.
+// 응답의 RESULT 코드가 호출 한도 초과나 인증키 오류라면 KeyProvider가 공급하는 다음 키로 다시 요청하고,
+// 시도할 키가 없으면 마지막 결과를 *APIError로 감싸 반환합니다. 그 밖의 결과 코드는 본문을 그대로 반환합니다.
+// 대수 인자(AGE, DAESU, ERACO)는 "22", "제22대" 어느 쪽으로 주어도 엔드포인트가 받는 형식으로 바꿔 보냅니다 (WithoutTermNormalization 참고).
 func (c *Client) FetchApiData(endpoint string, method string, params map[string]string) ([]byte, error) {
+	if !c.rawTerms {
+		params = normalizeTermParams(params)
+	}
 	fullURL := fmt.Sprintf("%s/%s", c.baseURL, endpoint)
 	parsedURL, err := url.ParseRequestURI(fullURL)
 	if err != nil {
 		return nil, fmt.Errorf("invalid URL: %w", err)
 	}
 
-	query := parsedURL.Query()
-	query.Add("KEY", c.apiKey)
-	query.Add("Type", "json") // OpenAPI 응답 타입을 JSON으로 고정
+	tried := make(map[string]bool)
+	var lastErr error
+	for {
+		key, err := c.keys.Key()
+		if err != nil {
+			if lastErr != nil {
+				return nil, fmt.Errorf("%w (last result: %w)", err, lastErr)
+			}
+			return nil, err
+		}
+		if tried[key] {
+			// 공급자가 이미 실패한 키를 다시 주면 더 시도할 키가 없는 것입니다.
+			return nil, fmt.Errorf("%w: %w", ErrNoAPIKey, lastErr)
+		}
+		tried[key] = true
+
+		respBody, err := c.fetchWithKey(parsedURL, method, params, key)
+		if err != nil {
+			return nil, err
+		}
+
+		code, message := parseResultCode(respBody)
+		c.keys.Report(key, code)
+		if IsKeyQuotaCode(code) || IsInvalidKeyCode(code) {
+			lastErr = &APIError{Code: code, Message: message}
+			continue // 다음 키로 재시도
+		}
+		return respBody, nil
+	}
+}
+
+// fetchWithKey는 주어진 인증키로 한 번의 OpenAPI 요청을 보냅니다.
+func (c *Client) fetchWithKey(endpointURL *url.URL, method string, params map[string]string, key string) ([]byte, error) {
+	query := endpointURL.Query()
 	for k, v := range params {
 		query.Add(k, v)
 	}
-	parsedURL.RawQuery = query.Encode()
+	query.Set("KEY", key)
+	query.Set("Type", "json") // OpenAPI 응답 타입을 JSON으로 고정
+	reqURL := *endpointURL
+	reqURL.RawQuery = query.Encode()
 
-	req, err := http.NewRequest(method, parsedURL.String(), nil) // GET 요청이므로 body는 nil
+	req, err := c.newRequest(method, reqURL.String(), requestOpenAPI)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrRequestFailed, err) // SDK 에러 사용
+		return nil, err
+	}
+	if req.Header.Get("Accept") == "" { // WithHeader로 지정한 값을 우선합니다.
+		req.Header.Set("Accept", "application/json")
 	}
 
-	req.Header.Add("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:115.0) Gecko/20100101 Firefox/115.0")
-	req.Header.Add("Accept", "application/json")
-	req.Header.Add("Content-Type", "application/json")
-
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.do(c.httpClient, req)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrDownloadFailed, err) // SDK 에러 사용
+		return nil, fmt.Errorf("%w: %w", ErrDownloadFailed, err) // SDK 에러 사용 (원인 에러도 함께 감쌉니다)
 	}
 	defer resp.Body.Close()
 
@@ -186,160 +283,153 @@ func StructToMapString(obj interface{}) (map[string]string, error) {
 		field := v.Field(i)
 		fieldType := t.Field(i)
 		jsonTag := fieldType.Tag.Get("json")
+		if jsonTag == "-" {
+			continue // json:"-" 필드는 요청 인자에서 제외합니다.
+		}
 
 		key := fieldType.Name
-		if jsonTag != "" && jsonTag != "-" {
-			key = jsonTag
+		if name, _, _ := strings.Cut(jsonTag, ","); name != "" {
+			key = name
 		}
 
-		switch field.Kind() {
-		case reflect.String:
-			result[key] = field.String()
-		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-			result[key] = strconv.FormatInt(field.Int(), 10)
-		case reflect.Float32, reflect.Float64:
-			result[key] = strconv.FormatFloat(field.Float(), 'f', -1, 64)
-		case reflect.Bool:
-			result[key] = strconv.FormatBool(field.Bool())
-		default:
-			result[key] = fmt.Sprintf("%v", field.Interface())
+		if field.Kind() == reflect.Ptr {
+			if field.IsNil() {
+				continue // 값이 없는 선택 인자(nil 포인터)는 보내지 않습니다.
+			}
+			field = field.Elem()
 		}
+		result[key] = formatParam(field)
 	}
 	return result, nil
 }
 
-// FetchBills는 TVBPMBILL11 OpenAPI를 호출하여 법률안 심사 및 처리 정보를 가져옵니다.
-func (c *Client) FetchBills(params models.TVBPMBILL11RequestParams) (*models.TVBPMBILL11Response, error) {
-	reqParamsMap, err := StructToMapString(params)
+// formatParam은 요청 인자 필드 값을 문자열로 변환합니다.
+func formatParam(field reflect.Value) string {
+	switch field.Kind() {
+	case reflect.String:
+		return field.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(field.Int(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(field.Float(), 'f', -1, 64)
+	case reflect.Bool:
+		return strconv.FormatBool(field.Bool())
+	default:
+		return fmt.Sprintf("%v", field.Interface())
+	}
+}
+
+// mergeParams는 필수 인자와 선택 인자를 하나의 요청 인자 맵으로 합칩니다.
+// 선택 인자 중 빈 문자열은 검색 조건이 아니므로 보내지 않습니다.
+func mergeParams(params any, optional any) (map[string]string, error) {
+	merged, err := StructToMapString(params)
 	if err != nil {
 		return nil, fmt.Errorf("parameter conversion failed: %w", err)
 	}
-
-	data, err := c.FetchApiData("TVBPMBILL11", http.MethodGet, reqParamsMap) // TVBPMBILL11은 GET 메소드 사용
+	extra, err := StructToMapString(optional)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("parameter conversion failed: %w", err)
 	}
-
-	var resp models.TVBPMBILL11Response
-	if err := json.Unmarshal(data, &resp); err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrDownloadFailed, err) // 응답 파싱 실패도 SDK 에러로 처리
+	for key, value := range extra {
+		if value != "" {
+			merged[key] = value
+		}
 	}
-	return &resp, nil
+	return merged, nil
+}
+
+// FetchBills는 TVBPMBILL11 OpenAPI를 호출하여 법률안 심사 및 처리 정보를 가져옵니다.
+func (c *Client) FetchBills(params models.TVBPMBILL11RequestParams) (*models.TVBPMBILL11Response, error) {
+	return c.FetchBillsWithOptions(params, models.TVBPMBILL11OptionalParams{})
 }
 
 // FetchAllMembers는 ALLNAMEMBER OpenAPI를 호출하여 국회의원 정보 통합 데이터를 가져옵니다.
 func (c *Client) FetchAllMembers(params models.AllNameMemberRequestParams) (*models.AllNameMemberResponse, error) {
-	reqParamsMap, err := StructToMapString(params)
-	if err != nil {
-		return nil, fmt.Errorf("parameter conversion failed: %w", err)
-	}
-
-	data, err := c.FetchApiData("ALLNAMEMBER", http.MethodGet, reqParamsMap)
-	if err != nil {
-		return nil, err
-	}
-
-	var resp models.AllNameMemberResponse
-	if err := json.Unmarshal(data, &resp); err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrDownloadFailed, err)
-	}
-	return &resp, nil
+	return c.FetchAllMembersWithOptions(params, models.AllNameMemberOptionalParams{})
 }
 
 // FetchBillConferenceList는 VCONFBILLCONFLIST OpenAPI를 호출하여 의안별 회의록 목록을 가져옵니다.
 func (c *Client) FetchBillConferenceList(params models.VCONFBILLCONFLISTRequestParams) (*models.VCONFBILLCONFLISTResponse, error) {
-	// int 필드가 있는 요청 파라미터를 StructToMapString이 처리할 수 있도록 수정하거나,
-	// 여기서 직접 map[string]string을 구성해야 합니다.
-	// 현재 StructToMapString은 int를 string으로 변환하는 로직을 가지고 있으므로 활용 가능합니다.
-	reqParamsMap, err := StructToMapString(params)
-	if err != nil {
-		return nil, fmt.Errorf("parameter conversion failed: %w", err)
-	}
-
-	data, err := c.FetchApiData("VCONFBILLCONFLIST", http.MethodGet, reqParamsMap)
-	if err != nil {
-		return nil, err
-	}
-
-	var resp models.VCONFBILLCONFLISTResponse
-	if err := json.Unmarshal(data, &resp); err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrDownloadFailed, err)
-	}
-	return &resp, nil
+	return c.FetchBillConferenceListWithOptions(params, models.VCONFBILLCONFLISTOptionalParams{})
 }
 
 // FetchMeetingConferenceList는 VCONFPHCONFLIST OpenAPI를 호출하여 회의록 통합 데이터를 가져옵니다.
 func (c *Client) FetchMeetingConferenceList(params models.VCONFPHCONFLISTRequestParams) (*models.VCONFPHCONFLISTResponse, error) {
-	reqParamsMap, err := StructToMapString(params)
-	if err != nil {
-		return nil, fmt.Errorf("parameter conversion failed: %w", err)
-	}
-
-	data, err := c.FetchApiData("VCONFPHCONFLIST", http.MethodGet, reqParamsMap)
-	if err != nil {
-		return nil, err
-	}
-
-	var resp models.VCONFPHCONFLISTResponse
-	if err := json.Unmarshal(data, &resp); err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrDownloadFailed, err)
-	}
-	return &resp, nil
+	return c.FetchMeetingConferenceListWithOptions(params, models.VCONFPHCONFLISTOptionalParams{})
 }
 
 // FetchMemberVoteResult는 nojepdqqaweusdfbi OpenAPI를 호출하여 국회의원 본회의 표결정보를 가져옵니다.
 func (c *Client) FetchMemberVoteResult(params models.NojepdqqaweusdfbiRequestParams) (*models.NojepdqqaweusdfbiResponse, error) {
-	reqParamsMap, err := StructToMapString(params)
-	if err != nil {
-		return nil, fmt.Errorf("parameter conversion failed: %w", err)
-	}
-
-	data, err := c.FetchApiData("nojepdqqaweusdfbi", http.MethodGet, reqParamsMap)
-	if err != nil {
-		return nil, err
-	}
-
-	var resp models.NojepdqqaweusdfbiResponse
-	if err := json.Unmarshal(data, &resp); err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrDownloadFailed, err)
-	}
-	return &resp, nil
+	return c.FetchMemberVoteResultWithOptions(params, models.NojepdqqaweusdfbiOptionalParams{})
 }
 
 // FetchHistoricalMembers는 nprlapfmaufmqytet OpenAPI를 호출하여 역대 국회의원 현황을 가져옵니다.
 func (c *Client) FetchHistoricalMembers(params models.NprlapfmaufmqytetRequestParams) (*models.NprlapfmaufmqytetResponse, error) {
-	reqParamsMap, err := StructToMapString(params)
+	return c.FetchHistoricalMembersWithOptions(params, models.NprlapfmaufmqytetOptionalParams{})
+}
+
+// FetchMemberDetails는 nwvrqwxyaytdsfvhu OpenAPI를 호출하여 국회의원 인적사항을 가져옵니다.
+func (c *Client) FetchMemberDetails(params models.NwvrqwxyaytdsfvhuRequestParams) (*models.NwvrqwxyaytdsfvhuResponse, error) {
+	return c.FetchMemberDetailsWithOptions(params, models.NwvrqwxyaytdsfvhuOptionalParams{})
+}
+
+// fetchJSON은 필수 인자와 선택 인자를 합쳐 endpoint를 GET으로 호출하고 응답을 T로 디코딩합니다.
+func fetchJSON[T any](c *Client, endpoint string, params, optional any) (*T, error) {
+	reqParamsMap, err := mergeParams(params, optional)
 	if err != nil {
-		return nil, fmt.Errorf("parameter conversion failed: %w", err)
+		return nil, err
 	}
-
-	data, err := c.FetchApiData("nprlapfmaufmqytet", http.MethodGet, reqParamsMap)
+	data, err := c.FetchApiData(endpoint, http.MethodGet, reqParamsMap)
 	if err != nil {
 		return nil, err
 	}
-
-	var resp models.NprlapfmaufmqytetResponse
+	var resp T
 	if err := json.Unmarshal(data, &resp); err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrDownloadFailed, err)
+		return nil, fmt.Errorf("%w: %v", ErrDownloadFailed, err) // 응답 파싱 실패도 SDK 에러로 처리
 	}
 	return &resp, nil
 }
 
-// FetchMemberDetails는 nwvrqwxyaytdsfvhu OpenAPI를 호출하여 국회의원 인적사항을 가져옵니다.
-func (c *Client) FetchMemberDetails(params models.NwvrqwxyaytdsfvhuRequestParams) (*models.NwvrqwxyaytdsfvhuResponse, error) {
-	reqParamsMap, err := StructToMapString(params)
-	if err != nil {
-		return nil, fmt.Errorf("parameter conversion failed: %w", err)
-	}
+// FetchBillsWithOptions는 FetchBills와 같지만 선택 인자(의안명, 제안자, 소관위 등)로 검색 조건을 추가합니다.
+func (c *Client) FetchBillsWithOptions(params models.TVBPMBILL11RequestParams, optional models.TVBPMBILL11OptionalParams) (*models.TVBPMBILL11Response, error) {
+	return fetchJSON[models.TVBPMBILL11Response](c, "TVBPMBILL11", params, optional)
+}
 
-	data, err := c.FetchApiData("nwvrqwxyaytdsfvhu", http.MethodGet, reqParamsMap)
-	if err != nil {
-		return nil, err
-	}
+// FetchAllMembersWithOptions는 FetchAllMembers와 같지만 선택 인자(의원명, 정당명 등)로 검색 조건을 추가합니다.
+func (c *Client) FetchAllMembersWithOptions(params models.AllNameMemberRequestParams, optional models.AllNameMemberOptionalParams) (*models.AllNameMemberResponse, error) {
+	return fetchJSON[models.AllNameMemberResponse](c, "ALLNAMEMBER", params, optional)
+}
 
-	var resp models.NwvrqwxyaytdsfvhuResponse
-	if err := json.Unmarshal(data, &resp); err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrDownloadFailed, err)
+// FetchBillConferenceListWithOptions는 FetchBillConferenceList와 같지만 선택 인자(회의일자, 회의종류)로 검색 조건을 추가합니다.
+func (c *Client) FetchBillConferenceListWithOptions(params models.VCONFBILLCONFLISTRequestParams, optional models.VCONFBILLCONFLISTOptionalParams) (*models.VCONFBILLCONFLISTResponse, error) {
+	return fetchJSON[models.VCONFBILLCONFLISTResponse](c, "VCONFBILLCONFLIST", params, optional)
+}
+
+// FetchMeetingConferenceListWithOptions는 FetchMeetingConferenceList와 같지만 선택 인자(회기, 차수, 회의일자 등)로 검색 조건을 추가합니다.
+func (c *Client) FetchMeetingConferenceListWithOptions(params models.VCONFPHCONFLISTRequestParams, optional models.VCONFPHCONFLISTOptionalParams) (*models.VCONFPHCONFLISTResponse, error) {
+	return fetchJSON[models.VCONFPHCONFLISTResponse](c, "VCONFPHCONFLIST", params, optional)
+}
+
+// FetchMemberVoteResultWithOptions는 FetchMemberVoteResult와 같지만 선택 인자(의원, 정당, 표결결과 등)로 검색 조건을 추가합니다.
+func (c *Client) FetchMemberVoteResultWithOptions(params models.NojepdqqaweusdfbiRequestParams, optional models.NojepdqqaweusdfbiOptionalParams) (*models.NojepdqqaweusdfbiResponse, error) {
+	return fetchJSON[models.NojepdqqaweusdfbiResponse](c, "nojepdqqaweusdfbi", params, optional)
+}
+
+// FetchHistoricalMembersWithOptions는 FetchHistoricalMembers와 같지만 선택 인자(이름, 생년월일 등)로 검색 조건을 추가합니다.
+func (c *Client) FetchHistoricalMembersWithOptions(params models.NprlapfmaufmqytetRequestParams, optional models.NprlapfmaufmqytetOptionalParams) (*models.NprlapfmaufmqytetResponse, error) {
+	return fetchJSON[models.NprlapfmaufmqytetResponse](c, "nprlapfmaufmqytet", params, optional)
+}
+
+// FetchMemberDetailsWithOptions는 FetchMemberDetails와 같지만 선택 인자(이름, 정당, 선거구 등)로 검색 조건을 추가합니다.
+func (c *Client) FetchMemberDetailsWithOptions(params models.NwvrqwxyaytdsfvhuRequestParams, optional models.NwvrqwxyaytdsfvhuOptionalParams) (*models.NwvrqwxyaytdsfvhuResponse, error) {
+	return fetchJSON[models.NwvrqwxyaytdsfvhuResponse](c, "nwvrqwxyaytdsfvhu", params, optional)
+}
+
+// normalizeTermParams는 대수 인자를 terms.NormalizeParam으로 바꾼 복사본을 반환합니다. 호출자의 맵은 수정하지 않습니다.
+func normalizeTermParams(params map[string]string) map[string]string {
+	out := make(map[string]string, len(params))
+	for name, value := range params {
+		out[name] = terms.NormalizeParam(name, value)
 	}
-	return &resp, nil
+	return out
 }
@@ -0,0 +1,296 @@
+package main
+
+import (
+	"assembly_go"
+	"assembly_go/models"
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// maxPageSize는 포털이 한 번에 돌려주는 최대 건수입니다.
+const maxPageSize = 1000
+
+// pageFetcher는 pIndex 페이지를 pSize건씩 요청해 행과 전체 건수를 돌려줍니다.
+// RESULT 오류 응답은 빈 페이지가 아니라 에러로 돌려줘야 CLI가 0이 아닌 코드로 끝납니다.
+type pageFetcher[T any] func(client *assembly_go.Client, pIndex, pSize int) (rows []T, total int, err error)
+
+// fetchAll은 1페이지부터 차례로 요청해 전체(또는 limit건)를 모읍니다.
+// 포털이 알려준 전체 건수에 도달했거나 빈 페이지, 덜 찬 페이지를 받으면 멈춥니다.
+func fetchAll[T any](client *assembly_go.Client, common *commonFlags, fetch pageFetcher[T]) ([]T, error) {
+	var all []T
+	for pIndex := 1; ; pIndex++ {
+		rows, total, err := fetch(client, pIndex, common.pageSize)
+		if err != nil {
+			return nil, fmt.Errorf("page %d: %w", pIndex, err)
+		}
+		all = append(all, rows...)
+
+		if common.limit > 0 && len(all) >= common.limit {
+			return all[:common.limit], nil
+		}
+		if len(rows) < common.pageSize || (total > 0 && len(all) >= total) {
+			return all, nil
+		}
+	}
+}
+
+// query는 클라이언트를 만들고 fetch로 전체 페이지를 모아 출력합니다.
+func query[T any](env *environment, common *commonFlags, layout tableLayout, fetch pageFetcher[T]) error {
+	client, err := newClient(common.config)
+	if err != nil {
+		return err
+	}
+	rows, err := fetchAll(client, common, fetch)
+	if err != nil {
+		return err
+	}
+	return emit(env, common, rows, layout)
+}
+
+// requireFlags는 필수 플래그가 모두 채워졌는지 확인합니다. 빠진 것이 있으면 도움말을 출력하고 errUsage를 반환합니다.
+func requireFlags(env *environment, fs *flag.FlagSet, values map[string]string) error {
+	var missing []string
+	fs.VisitAll(func(f *flag.Flag) {
+		if v, ok := values[f.Name]; ok && strings.TrimSpace(v) == "" {
+			missing = append(missing, "-"+f.Name)
+		}
+	})
+	if len(missing) == 0 {
+		return nil
+	}
+	fmt.Fprintf(env.stderr, "필수 플래그가 빠졌습니다: %s\n\n", strings.Join(missing, ", "))
+	fs.Usage()
+	return errUsage
+}
+
+// optional은 빈 문자열이면 nil을, 아니면 값의 포인터를 반환합니다. 선택 인자 구조체를 채울 때 사용합니다.
+func optional(s string) *string {
+	if s = strings.TrimSpace(s); s == "" {
+		return nil
+	}
+	return &s
+}
+
+func runBills(env *environment, args []string) error {
+	var common commonFlags
+	var opt models.TVBPMBILL11OptionalParams
+	fs := newFlagSet(env, "bills", "bills [플래그]")
+	fs.StringVar(&opt.AGE, "age", "", "대수 (예: 22)")
+	fs.StringVar(&opt.BILL_ID, "bill-id", "", "의안ID")
+	fs.StringVar(&opt.BILL_NO, "bill-no", "", "의안번호")
+	fs.StringVar(&opt.BILL_NAME, "name", "", "의안명")
+	fs.StringVar(&opt.PROPOSER, "proposer", "", "제안자")
+	fs.StringVar(&opt.PROPOSER_KIND, "proposer-kind", "", "제안자구분 (예: 의원, 정부, 위원장)")
+	fs.StringVar(&opt.CURR_COMMITTEE, "committee", "", "소관위원회명")
+	fs.StringVar(&opt.CURR_COMMITTEE_ID, "committee-id", "", "소관위원회코드")
+	fs.StringVar(&opt.PROC_RESULT_CD, "result", "", "본회의 심의결과 (예: 원안가결)")
+	fs.StringVar(&opt.PROC_DT, "proc-date", "", "의결일 (YYYY-MM-DD)")
+	common.register(fs)
+	if _, err := parseFlags(fs, &common, args); err != nil {
+		return err
+	}
+
+	layout := tableLayout{fields: []string{"BILL_NO", "BILL_NAME", "PROPOSER", "PROPOSE_DT", "CURR_COMMITTEE", "PROC_RESULT_CD"}}
+	return query(env, &common, layout, func(client *assembly_go.Client, pIndex, pSize int) ([]models.TVBPMBILL11Row, int, error) {
+		resp, err := client.FetchBillsWithOptions(models.TVBPMBILL11RequestParams{Pindex: strconv.Itoa(pIndex), Psize: strconv.Itoa(pSize)}, opt)
+		if err == nil {
+			err = assembly_go.CheckResult(resp)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.AllRows(), resp.TotalCount(), nil
+	})
+}
+
+func runMembers(env *environment, args []string) error {
+	var common commonFlags
+	var code, name, party, committee string
+	fs := newFlagSet(env, "members", "members [플래그]")
+	fs.StringVar(&code, "code", "", "국회의원코드")
+	fs.StringVar(&name, "name", "", "국회의원명")
+	fs.StringVar(&party, "party", "", "정당명")
+	fs.StringVar(&committee, "committee", "", "소속위원회명")
+	common.register(fs)
+	if _, err := parseFlags(fs, &common, args); err != nil {
+		return err
+	}
+
+	opt := models.AllNameMemberOptionalParams{
+		NAAS_CD: optional(code), NAAS_NM: optional(name), PLPT_NM: optional(party), BLNG_CMIT_NM: optional(committee),
+	}
+	layout := tableLayout{fields: []string{"NAAS_CD", "NAAS_NM", "PLPT_NM", "ELECD_NM", "RLCT_DIV_NM", "GTELT_ERACO"}}
+	return query(env, &common, layout, func(client *assembly_go.Client, pIndex, pSize int) ([]models.AllNameMemberRow, int, error) {
+		resp, err := client.FetchAllMembersWithOptions(models.AllNameMemberRequestParams{Pindex: strconv.Itoa(pIndex), Psize: strconv.Itoa(pSize)}, opt)
+		if err == nil {
+			err = assembly_go.CheckResult(resp)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.AllRows(), resp.TotalCount(), nil
+	})
+}
+
+func runMember(env *environment, args []string) error {
+	var common commonFlags
+	var code, name, party, district, committee, sex string
+	fs := newFlagSet(env, "member", "member [플래그] [이름]")
+	fs.StringVar(&code, "code", "", "국회의원코드 (MONA_CD)")
+	fs.StringVar(&name, "name", "", "이름 (위치 인자로도 지정할 수 있습니다)")
+	fs.StringVar(&party, "party", "", "정당명")
+	fs.StringVar(&district, "district", "", "선거구")
+	fs.StringVar(&committee, "committee", "", "소속 위원회")
+	fs.StringVar(&sex, "sex", "", "성별 (남, 여)")
+	common.register(fs)
+	rest, err := parseFlags(fs, &common, args)
+	if err != nil {
+		return err
+	}
+	if name == "" && len(rest) > 0 {
+		name = strings.Join(rest, " ")
+	}
+	if name == "" && code == "" {
+		fmt.Fprintln(env.stderr, "이름 또는 -code가 필요합니다.")
+		fmt.Fprintln(env.stderr)
+		fs.Usage()
+		return errUsage
+	}
+
+	opt := models.NwvrqwxyaytdsfvhuOptionalParams{
+		HG_NM: optional(name), MONA_CD: optional(code), POLY_NM: optional(party),
+		ORIG_NM: optional(district), CMITS: optional(committee), SEX_GBN_NM: optional(sex),
+	}
+	return query(env, &common, tableLayout{vertical: true}, func(client *assembly_go.Client, pIndex, pSize int) ([]models.NwvrqwxyaytdsfvhuRow, int, error) {
+		resp, err := client.FetchMemberDetailsWithOptions(models.NwvrqwxyaytdsfvhuRequestParams{Pindex: strconv.Itoa(pIndex), Psize: strconv.Itoa(pSize)}, opt)
+		if err == nil {
+			err = assembly_go.CheckResult(resp)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.AllRows(), resp.TotalCount(), nil
+	})
+}
+
+func runVotes(env *environment, args []string) error {
+	var common commonFlags
+	var age, billID, name, party, result, code, memberNo string
+	fs := newFlagSet(env, "votes", "votes -age <대수> -bill-id <의안ID> [플래그]")
+	fs.StringVar(&age, "age", "", "대수 (필수, 예: 22)")
+	fs.StringVar(&billID, "bill-id", "", "의안ID (필수)")
+	fs.StringVar(&name, "name", "", "의원명")
+	fs.StringVar(&party, "party", "", "정당명")
+	fs.StringVar(&result, "result", "", "표결결과 (찬성, 반대, 기권 등)")
+	fs.StringVar(&code, "code", "", "국회의원코드 (MONA_CD)")
+	fs.StringVar(&memberNo, "member-no", "", "의원번호")
+	common.register(fs)
+	if _, err := parseFlags(fs, &common, args); err != nil {
+		return err
+	}
+	if err := requireFlags(env, fs, map[string]string{"age": age, "bill-id": billID}); err != nil {
+		return err
+	}
+
+	params := models.NojepdqqaweusdfbiRequestParams{AGE: age, BILL_ID: billID}
+	opt := models.NojepdqqaweusdfbiOptionalParams{
+		HG_NM: optional(name), POLY_NM: optional(party), RESULT_VOTE_MOD: optional(result),
+		MONA_CD: optional(code), MEMBER_NO: optional(memberNo),
+	}
+	layout := tableLayout{fields: []string{"HG_NM", "POLY_NM", "ORIG_NM", "RESULT_VOTE_MOD", "VOTE_DATE"}}
+	return query(env, &common, layout, func(client *assembly_go.Client, pIndex, pSize int) ([]models.NojepdqqaweusdfbiRow, int, error) {
+		params.Pindex, params.Psize = strconv.Itoa(pIndex), strconv.Itoa(pSize)
+		resp, err := client.FetchMemberVoteResultWithOptions(params, opt)
+		if err == nil {
+			err = assembly_go.CheckResult(resp)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.AllRows(), resp.TotalCount(), nil
+	})
+}
+
+func runConferences(env *environment, args []string) error {
+	var common commonFlags
+	var billID, era, date, kind, session, degree string
+	fs := newFlagSet(env, "conferences", "conferences (-bill-id <의안ID> | -era <대수>) [플래그]")
+	fs.StringVar(&billID, "bill-id", "", "의안ID (지정하면 해당 의안을 다룬 회의록 목록)")
+	fs.StringVar(&era, "era", "", "대수 (예: 제22대, -bill-id가 없을 때 필수)")
+	fs.StringVar(&date, "date", "", "회의일자 (YYYY-MM-DD)")
+	fs.StringVar(&kind, "kind", "", "회의록 종류 (-bill-id와 함께 사용)")
+	fs.StringVar(&session, "session", "", "회기 (-era와 함께 사용)")
+	fs.StringVar(&degree, "degree", "", "차수 (-era와 함께 사용)")
+	common.register(fs)
+	if _, err := parseFlags(fs, &common, args); err != nil {
+		return err
+	}
+
+	if billID != "" {
+		opt := models.VCONFBILLCONFLISTOptionalParams{CONF_DT: optional(date), CONF_KND: optional(kind)}
+		layout := tableLayout{fields: []string{"CONF_ID", "CONF_DT", "CONF_KND", "SESS", "DGR", "BILL_NM"}}
+		return query(env, &common, layout, func(client *assembly_go.Client, pIndex, pSize int) ([]models.VCONFBILLCONFLISTRow, int, error) {
+			resp, err := client.FetchBillConferenceListWithOptions(models.VCONFBILLCONFLISTRequestParams{Pindex: pIndex, Psize: pSize, BILL_ID: billID}, opt)
+			if err == nil {
+				err = assembly_go.CheckResult(resp)
+			}
+			if err != nil {
+				return nil, 0, err
+			}
+			return resp.AllRows(), resp.TotalCount(), nil
+		})
+	}
+
+	if err := requireFlags(env, fs, map[string]string{"era": era}); err != nil {
+		return err
+	}
+	opt := models.VCONFPHCONFLISTOptionalParams{SESS: optional(session), DGR: optional(degree), CONF_DT: optional(date)}
+	layout := tableLayout{fields: []string{"CONF_ID", "CONF_DT", "CONF_KND", "CMIT_NM", "SESS", "DGR"}}
+	return query(env, &common, layout, func(client *assembly_go.Client, pIndex, pSize int) ([]models.VCONFPHCONFLISTRow, int, error) {
+		resp, err := client.FetchMeetingConferenceListWithOptions(models.VCONFPHCONFLISTRequestParams{Pindex: strconv.Itoa(pIndex), Psize: strconv.Itoa(pSize), ERACO: era}, opt)
+		if err == nil {
+			err = assembly_go.CheckResult(resp)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.AllRows(), resp.TotalCount(), nil
+	})
+}
+
+func runHistory(env *environment, args []string) error {
+	var common commonFlags
+	var daesu, name, nameHan, birth, birthplace string
+	fs := newFlagSet(env, "history", "history -daesu <대수> [플래그]")
+	fs.StringVar(&daesu, "daesu", "", "대수 (필수, 예: 21)")
+	fs.StringVar(&name, "name", "", "이름")
+	fs.StringVar(&nameHan, "name-han", "", "이름(한자)")
+	fs.StringVar(&birth, "birth", "", "생년월일")
+	fs.StringVar(&birthplace, "birthplace", "", "출생지")
+	common.register(fs)
+	if _, err := parseFlags(fs, &common, args); err != nil {
+		return err
+	}
+	if err := requireFlags(env, fs, map[string]string{"daesu": daesu}); err != nil {
+		return err
+	}
+
+	params := models.NprlapfmaufmqytetRequestParams{DAESU: daesu}
+	opt := models.NprlapfmaufmqytetOptionalParams{
+		NAME: optional(name), NAME_HAN: optional(nameHan), BIRTH: optional(birth), POSI: optional(birthplace),
+	}
+	layout := tableLayout{fields: []string{"DAESU", "NAME", "NAME_HAN", "BIRTH", "POSI", "DAE"}}
+	return query(env, &common, layout, func(client *assembly_go.Client, pIndex, pSize int) ([]models.NprlapfmaufmqytetRow, int, error) {
+		params.Pindex, params.Psize = strconv.Itoa(pIndex), strconv.Itoa(pSize)
+		resp, err := client.FetchHistoricalMembersWithOptions(params, opt)
+		if err == nil {
+			err = assembly_go.CheckResult(resp)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.AllRows(), resp.TotalCount(), nil
+	})
+}
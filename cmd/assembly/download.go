@@ -0,0 +1,341 @@
+package main
+
+import (
+	"assembly_go"
+	"assembly_go/models"
+	"assembly_go/terms"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// downloadFlags는 download 하위 명령의 플래그입니다.
+type downloadFlags struct {
+	dir      string
+	workers  int
+	force    bool
+	failures string
+	manifest string
+	quiet    bool
+}
+
+func (f *downloadFlags) register(fs *flag.FlagSet) {
+	fs.StringVar(&f.dir, "dir", ".", "PDF를 저장할 디렉터리")
+	fs.IntVar(&f.workers, "workers", 4, "동시에 다운로드할 수")
+	fs.BoolVar(&f.force, "force", false, "이미 받은 파일도 다시 다운로드")
+	fs.StringVar(&f.failures, "failures", "", "실패 목록(JSON)을 저장할 경로 (기본값: <dir>/failures.json)")
+	fs.StringVar(&f.manifest, "manifest", "", "다운로드 기록(JSON)을 저장할 경로 (기본값: <dir>/manifest.json)")
+	fs.BoolVar(&f.quiet, "quiet", false, "파일별 진행 상황을 출력하지 않음")
+}
+
+func (f *downloadFlags) validate() error {
+	if f.workers < 1 {
+		return fmt.Errorf("-workers는 1 이상이어야 합니다: %d", f.workers)
+	}
+	return nil
+}
+
+func (f *downloadFlags) failuresPath() string {
+	if f.failures != "" {
+		return f.failures
+	}
+	return filepath.Join(f.dir, "failures.json")
+}
+
+// downloadJob은 PDF 하나를 내려받는 작업입니다.
+type downloadJob struct {
+	id      string // 의안ID 또는 회의ID
+	url     string // 회의록 DOWN_URL (법안은 비어있음)
+	path    string // dir 기준 상대 경로
+	meeting bool   // 회의록이면 url로, 법안이면 의안ID로 filegate에서 받습니다.
+}
+
+// errNoDownloadURL은 회의록 행에 DOWN_URL이 없을 때 실패 목록에 기록됩니다.
+var errNoDownloadURL = errors.New("no DOWN_URL in conference row")
+
+// downloadFailure는 실패 목록 파일의 항목입니다. 같은 명령을 다시 실행하면 성공한 파일은 건너뛰므로 실패한 것만 재시도됩니다.
+type downloadFailure struct {
+	ID    string `json:"id"`
+	URL   string `json:"url,omitempty"`
+	Path  string `json:"path"`
+	Error string `json:"error"`
+}
+
+func runDownload(env *environment, args []string) error {
+	if len(args) == 0 || strings.HasPrefix(args[0], "-") {
+		fmt.Fprintln(env.stderr, "사용법: assembly download (bills | meetings) [플래그]")
+		if len(args) > 0 && (args[0] == "-h" || args[0] == "-help" || args[0] == "--help") {
+			return flag.ErrHelp
+		}
+		return errUsage
+	}
+
+	switch args[0] {
+	case "bills":
+		return runDownloadBills(env, args[1:])
+	case "meetings":
+		return runDownloadMeetings(env, args[1:])
+	}
+	fmt.Fprintf(env.stderr, "알 수 없는 다운로드 대상 %q (bills, meetings 중 하나)\n", args[0])
+	return errUsage
+}
+
+func runDownloadBills(env *environment, args []string) error {
+	var common commonFlags
+	var opts downloadFlags
+	var age string
+	fs := newFlagSet(env, "download bills", "download bills -age <대수> [플래그]")
+	fs.StringVar(&age, "age", "", "대수 (필수, 예: 22)")
+	common.registerFetch(fs)
+	opts.register(fs)
+	if _, err := parseFlags(fs, &common, args); err != nil {
+		return err
+	}
+	if err := requireFlags(env, fs, map[string]string{"age": age}); err != nil {
+		return err
+	}
+	if err := opts.validate(); err != nil {
+		return err
+	}
+
+	client, err := newClient(common.config)
+	if err != nil {
+		return err
+	}
+	opt := models.TVBPMBILL11OptionalParams{AGE: age}
+	rows, err := fetchAll(client, &common, func(client *assembly_go.Client, pIndex, pSize int) ([]models.TVBPMBILL11Row, int, error) {
+		resp, err := client.FetchBillsWithOptions(models.TVBPMBILL11RequestParams{Pindex: strconv.Itoa(pIndex), Psize: strconv.Itoa(pSize)}, opt)
+		if err == nil {
+			err = assembly_go.CheckResult(resp)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.AllRows(), resp.TotalCount(), nil
+	})
+	if err != nil {
+		return err
+	}
+	return download(env, client, &opts, billJobs(rows, age))
+}
+
+func runDownloadMeetings(env *environment, args []string) error {
+	var common commonFlags
+	var opts downloadFlags
+	var eraco string
+	fs := newFlagSet(env, "download meetings", "download meetings -eraco <대수> [플래그]")
+	fs.StringVar(&eraco, "eraco", "", "대수 (필수, 예: 22 또는 제22대)")
+	common.registerFetch(fs)
+	opts.register(fs)
+	if _, err := parseFlags(fs, &common, args); err != nil {
+		return err
+	}
+	if err := requireFlags(env, fs, map[string]string{"eraco": eraco}); err != nil {
+		return err
+	}
+	if err := opts.validate(); err != nil {
+		return err
+	}
+
+	client, err := newClient(common.config)
+	if err != nil {
+		return err
+	}
+	era := terms.NormalizeEraco(eraco)
+	rows, err := fetchAll(client, &common, func(client *assembly_go.Client, pIndex, pSize int) ([]models.VCONFPHCONFLISTRow, int, error) {
+		resp, err := client.FetchMeetingConferenceList(models.VCONFPHCONFLISTRequestParams{Pindex: strconv.Itoa(pIndex), Psize: strconv.Itoa(pSize), ERACO: era})
+		if err == nil {
+			err = assembly_go.CheckResult(resp)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.AllRows(), resp.TotalCount(), nil
+	})
+	if err != nil {
+		return err
+	}
+	return download(env, client, &opts, meetingJobs(rows, eraco))
+}
+
+// eracoDir은 "제22대"나 "22"에서 디렉터리 이름으로 쓸 숫자만 뽑습니다. 대수로 읽을 수 없으면 정리한 원래 값을 씁니다.
+func eracoDir(eraco string) string {
+	if n, ok := terms.Number(eraco); ok {
+		return strconv.Itoa(n)
+	}
+	return sanitizeName(eraco)
+}
+
+// billJobs는 법안 행을 "<대수>/<의안번호>.pdf"로 저장하는 작업 목록으로 바꿉니다.
+func billJobs(rows []models.TVBPMBILL11Row, age string) []downloadJob {
+	names := make(uniqueNames)
+	jobs := make([]downloadJob, 0, len(rows))
+	for _, row := range rows {
+		dir := row.Age
+		if dir == "" {
+			dir = age
+		}
+		name := row.BillNumber
+		if name == "" {
+			name = row.BillId
+		}
+		path := names.claim(filepath.Join(sanitizeName(dir), sanitizeName(name)), row.BillId)
+		jobs = append(jobs, downloadJob{id: row.BillId, path: path})
+	}
+	return jobs
+}
+
+// meetingJobs는 회의록 행을 "<대수>/<회기>-<차수>-<위원회>.pdf"로 저장하는 작업 목록으로 바꿉니다.
+func meetingJobs(rows []models.VCONFPHCONFLISTRow, eraco string) []downloadJob {
+	names := make(uniqueNames)
+	jobs := make([]downloadJob, 0, len(rows))
+	for _, row := range rows {
+		committee := row.CMIT_NM
+		if committee == "" {
+			committee = row.CONF_KND
+		}
+		base := strings.Join([]string{sanitizeName(row.SESS), sanitizeName(row.DGR), sanitizeName(committee)}, "-")
+		path := names.claim(filepath.Join(eracoDir(eraco), base), row.CONF_ID)
+		jobs = append(jobs, downloadJob{id: row.CONF_ID, url: row.DOWN_URL, path: path, meeting: true})
+	}
+	return jobs
+}
+
+// uniqueNames는 이미 배정한 파일 경로입니다. 같은 이름이 다시 나오면 식별자를 붙여 구분합니다.
+type uniqueNames map[string]bool
+
+// claim은 base에 ".pdf"를 붙인 경로를 배정합니다. 이미 쓰인 이름이면 "-<id>"를 덧붙입니다.
+// 행 순서가 같으면 항상 같은 이름이 나오므로 다시 실행해도 이미 받은 파일을 찾을 수 있습니다.
+func (n uniqueNames) claim(base, id string) string {
+	path := base + ".pdf"
+	if n[path] {
+		path = base + "-" + sanitizeName(id) + ".pdf"
+	}
+	n[path] = true
+	return path
+}
+
+// sanitizeName은 파일 이름에 쓸 수 없거나 불편한 문자를 "_"로 바꿉니다. 빈 값은 "unknown"이 됩니다.
+func sanitizeName(s string) string {
+	s = strings.Map(func(r rune) rune {
+		switch {
+		case strings.ContainsRune(`/\:*?"<>|`, r), unicode.IsControl(r), unicode.IsSpace(r):
+			return '_'
+		}
+		return r
+	}, strings.TrimSpace(s))
+	s = strings.Trim(s, "._")
+	if s == "" {
+		return "unknown"
+	}
+	return s
+}
+
+// statusLabels는 진행 상황 줄에 출력할 작업 상태입니다. 중복은 내용이 같은 파일이 이미 있어 따로 저장하지 않은 경우입니다.
+var statusLabels = map[assembly_go.BulkStatus]string{
+	assembly_go.BulkDownloaded: "저장",
+	assembly_go.BulkSkipped:    "건너뜀",
+	assembly_go.BulkDuplicate:  "중복",
+	assembly_go.BulkFailed:     "실패",
+}
+
+// download는 jobs를 BulkDownloader로 내려받고, 진행 상황과 결과를 출력합니다. 실패가 있으면 목록을 저장하고 에러를 반환합니다.
+// 결과는 <dir>/manifest.json에 기록되므로 중단된 뒤 다시 실행하면 남은 작업만 처리합니다.
+func download(env *environment, client *assembly_go.Client, opts *downloadFlags, jobs []downloadJob) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	failures := make([]*downloadFailure, len(jobs))
+	done := 0
+	progress := func(i int, status string) {
+		done++
+		if !opts.quiet {
+			fmt.Fprintf(env.stderr, "[%d/%d] %s %s\n", done, len(jobs), status, filepath.ToSlash(jobs[i].path))
+		}
+	}
+
+	index := make(map[string]int, len(jobs))
+	bulkJobs := make([]assembly_go.DownloadJob, 0, len(jobs))
+	for i, job := range jobs {
+		if job.meeting && job.url == "" {
+			// 식별할 URL이 없으므로 매니페스트에 남기지 않고 실패 목록에만 기록합니다.
+			failures[i] = &downloadFailure{ID: job.id, Path: job.path, Error: errNoDownloadURL.Error()}
+			progress(i, statusLabels[assembly_go.BulkFailed])
+			continue
+		}
+		bj := assembly_go.DownloadJob{URL: job.url, Path: filepath.ToSlash(job.path)}
+		if !job.meeting {
+			bj = assembly_go.DownloadJob{BillID: job.id, Path: filepath.ToSlash(job.path)}
+		}
+		index[bj.Key()] = i
+		bulkJobs = append(bulkJobs, bj)
+	}
+
+	options := []assembly_go.BulkOption{
+		assembly_go.WithBulkConcurrency(opts.workers),
+		assembly_go.WithBulkAdoptExisting(), // 매니페스트 없이 받아둔 파일도 다시 받지 않습니다.
+		assembly_go.WithBulkProgress(func(r assembly_go.BulkResult) {
+			i := index[r.Job.Key()]
+			if r.Status == assembly_go.BulkFailed {
+				failures[i] = &downloadFailure{ID: jobs[i].id, URL: jobs[i].url, Path: jobs[i].path, Error: r.Entry.Error}
+			}
+			progress(i, statusLabels[r.Status])
+		}),
+	}
+	if opts.force {
+		options = append(options, assembly_go.WithBulkRedownload())
+	}
+	if opts.manifest != "" {
+		options = append(options, assembly_go.WithBulkManifest(opts.manifest))
+	}
+	bulk, err := assembly_go.NewBulkDownloader(client, opts.dir, options...)
+	if err != nil {
+		return err
+	}
+
+	summary, runErr := bulk.RunJobs(ctx, bulkJobs)
+	var failed []downloadFailure
+	for _, f := range failures {
+		if f != nil {
+			failed = append(failed, *f)
+		}
+	}
+	fmt.Fprintf(env.stderr, "완료: 전체 %d건, 다운로드 %d건, 건너뜀 %d건, 실패 %d건, 중복 %d건\n",
+		len(jobs), summary.Downloaded, summary.Skipped, len(failed), summary.Duplicates)
+	if runErr != nil {
+		return runErr
+	}
+
+	report := opts.failuresPath()
+	if len(failed) == 0 {
+		// 이전 실행의 실패 목록이 남아 있으면 혼동되므로 지웁니다.
+		if err := os.Remove(report); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		return nil
+	}
+	if err := writeFailures(report, failed); err != nil {
+		return err
+	}
+	return fmt.Errorf("%d건 다운로드 실패 (목록: %s)", len(failed), report)
+}
+
+// writeFailures는 실패 목록을 JSON 배열로 저장합니다.
+func writeFailures(path string, failures []downloadFailure) error {
+	data, err := json.MarshalIndent(failures, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
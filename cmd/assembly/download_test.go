@@ -0,0 +1,138 @@
+package main
+
+import (
+	"assembly_go/assemblytest"
+	"assembly_go/models"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDownloadBills(t *testing.T) {
+	srv := setupCLI(t)
+	t.Setenv("ASSEMBLY_RETRIES", "1")
+	dir := t.TempDir()
+
+	srv.AddBills(
+		models.TVBPMBILL11Row{BillId: "PRC_A", BillNumber: "2200001", Age: "22"},
+		models.TVBPMBILL11Row{BillId: "PRC_B", BillNumber: "2200002", Age: "22"},
+		models.TVBPMBILL11Row{BillId: "PRC_C", BillNumber: "2200003", Age: "22"}, // PDF 없음 → HTML 오류 페이지
+		models.TVBPMBILL11Row{BillId: "PRC_D", BillNumber: "2100001", Age: "21"},
+	)
+	srv.AddBillPDF("PRC_A", []byte("%PDF-A"))
+	srv.AddBillPDF("PRC_B", []byte("%PDF-B"))
+
+	code, _, stderr := runCLI("download", "bills", "-age", "22", "-dir", dir, "-workers", "2")
+	if code != 1 {
+		t.Fatalf("실패가 있으면 종료 코드는 1이어야 합니다. 결과값: %d (%s)", code, stderr)
+	}
+	if !strings.Contains(stderr, "다운로드 2건, 건너뜀 0건, 실패 1건") {
+		t.Errorf("요약이 올바르지 않습니다:\n%s", stderr)
+	}
+	for path, want := range map[string]string{"22/2200001.pdf": "%PDF-A", "22/2200002.pdf": "%PDF-B"} {
+		data, err := os.ReadFile(filepath.Join(dir, path))
+		if err != nil || string(data) != want {
+			t.Errorf("%s 기대값: %q, 결과값: %q (%v)", path, want, data, err)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, "22", "2200003.pdf")); !os.IsNotExist(err) {
+		t.Error("실패한 PDF는 저장하지 않아야 합니다")
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "manifest.json")); err != nil {
+		t.Errorf("다운로드 기록을 저장해야 합니다: %v", err)
+	}
+
+	var failures []downloadFailure
+	data, err := os.ReadFile(filepath.Join(dir, "failures.json"))
+	if err != nil {
+		t.Fatalf("실패 목록을 읽을 수 없습니다: %v", err)
+	}
+	if err := json.Unmarshal(data, &failures); err != nil {
+		t.Fatal(err)
+	}
+	if len(failures) != 1 || failures[0].ID != "PRC_C" || failures[0].Path != filepath.Join("22", "2200003.pdf") {
+		t.Errorf("실패 목록이 올바르지 않습니다: %+v", failures)
+	}
+
+	t.Run("이미 받은 파일은 건너뜀", func(t *testing.T) {
+		srv.Reset() // 요청 기록을 지우기 위해 데이터를 다시 넣습니다.
+		srv.AddBills(
+			models.TVBPMBILL11Row{BillId: "PRC_A", BillNumber: "2200001", Age: "22"},
+			models.TVBPMBILL11Row{BillId: "PRC_B", BillNumber: "2200002", Age: "22"},
+			models.TVBPMBILL11Row{BillId: "PRC_C", BillNumber: "2200003", Age: "22"},
+		)
+		srv.AddBillPDF("PRC_C", []byte("%PDF-C"))
+
+		code, _, stderr := runCLI("download", "bills", "-age", "22", "-dir", dir, "-quiet")
+		if code != 0 {
+			t.Fatalf("종료 코드 기대값: 0, 결과값: %d (%s)", code, stderr)
+		}
+		if got := srv.RequestCount(assemblytest.FileGatePath); got != 1 {
+			t.Errorf("filegate 요청 수 기대값: 1, 결과값: %d", got)
+		}
+		if strings.Contains(stderr, "[1/3]") {
+			t.Errorf("-quiet이면 파일별 진행 상황을 출력하지 않아야 합니다:\n%s", stderr)
+		}
+		if _, err := os.Stat(filepath.Join(dir, "failures.json")); !os.IsNotExist(err) {
+			t.Error("모두 성공하면 이전 실패 목록을 지워야 합니다")
+		}
+	})
+}
+
+func TestDownloadMeetings(t *testing.T) {
+	srv := setupCLI(t)
+	t.Setenv("ASSEMBLY_RETRIES", "1")
+	dir := t.TempDir()
+
+	srv.AddConferences(
+		models.VCONFPHCONFLISTRow{CONF_ID: "1", ERACO: "제22대", SESS: "제416회", DGR: "제3차", CMIT_NM: "국회운영위원회",
+			DOWN_URL: srv.AddFile("/records/1.pdf", []byte("%PDF-1"))},
+		models.VCONFPHCONFLISTRow{CONF_ID: "2", ERACO: "제22대", SESS: "제416회", DGR: "제3차", CMIT_NM: "국회운영위원회",
+			DOWN_URL: srv.AddFile("/records/2.pdf", []byte("%PDF-2"))},
+		models.VCONFPHCONFLISTRow{CONF_ID: "3", ERACO: "제22대", SESS: "제417회", DGR: "제1차", CONF_KND: "본회의",
+			DOWN_URL: srv.AddFile("/records/3.pdf", []byte("%PDF-3"))},
+		models.VCONFPHCONFLISTRow{CONF_ID: "4", ERACO: "제21대", SESS: "제400회", DGR: "제1차", CMIT_NM: "법제사법위원회",
+			DOWN_URL: srv.AddFile("/records/4.pdf", []byte("%PDF-4"))},
+	)
+
+	code, _, stderr := runCLI("download", "meetings", "-eraco", "22", "-dir", dir)
+	if code != 0 {
+		t.Fatalf("종료 코드 기대값: 0, 결과값: %d (%s)", code, stderr)
+	}
+	want := map[string]string{
+		"22/제416회-제3차-국회운영위원회.pdf":   "%PDF-1",
+		"22/제416회-제3차-국회운영위원회-2.pdf": "%PDF-2", // 같은 이름은 회의ID로 구분
+		"22/제417회-제1차-본회의.pdf":       "%PDF-3",
+	}
+	for path, content := range want {
+		data, err := os.ReadFile(filepath.Join(dir, path))
+		if err != nil || string(data) != content {
+			t.Errorf("%s 기대값: %q, 결과값: %q (%v)", path, content, data, err)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, "21")); !os.IsNotExist(err) {
+		t.Error("다른 대수의 회의록은 받지 않아야 합니다")
+	}
+	if got := srv.Requests()[0].Query.Get("ERACO"); got != "제22대" {
+		t.Errorf("ERACO 기대값: 제22대, 결과값: %q", got)
+	}
+}
+
+func TestSanitizeName(t *testing.T) {
+	tests := map[string]string{
+		"제416회":       "제416회",
+		"a/b\\c:d":    "a_b_c_d",
+		" 국회 운영 ":     "국회_운영",
+		"..":          "unknown",
+		"":            "unknown",
+		"x\ny?z*.pdf": "x_y_z_.pdf",
+	}
+	for in, want := range tests {
+		if got := sanitizeName(in); got != want {
+			t.Errorf("sanitizeName(%q) 기대값: %q, 결과값: %q", in, want, got)
+		}
+	}
+}
@@ -0,0 +1,211 @@
+// assembly는 국회 OpenAPI를 코드 없이 조회하는 명령행 도구입니다.
+//
+// 인증키와 클라이언트 설정은 환경 변수(ASSEMBLY_*, APIKEY)와 현재 디렉터리의 .env 파일에서 읽으며,
+// -config로 설정 파일(.json, .toml, .env)을 지정할 수도 있습니다.
+//
+//	assembly bills -age 22 -name 국회법 -format csv > bills.csv
+//	assembly members -party 더불어민주당
+//	assembly member 홍길동
+//	assembly votes -age 22 -bill-id PRC_... -result 반대 -format jsonl
+//	assembly conferences -bill-id PRC_...
+//	assembly history -daesu 21 -name 홍길동
+//	assembly download bills -age 22 -dir archive
+//	assembly download meetings -eraco 22 -dir archive -workers 8
+package main
+
+import (
+	"assembly_go"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// command는 하위 명령 하나입니다.
+type command struct {
+	name    string
+	summary string
+	run     func(env *environment, args []string) error
+}
+
+// commands는 지원하는 하위 명령 목록입니다. 도움말은 이 순서로 출력합니다.
+var commands = []command{
+	{"bills", "법률안 심사 및 처리 정보를 조회합니다 (TVBPMBILL11)", runBills},
+	{"members", "국회의원 정보 통합 목록을 조회합니다 (ALLNAMEMBER)", runMembers},
+	{"member", "국회의원 인적사항을 조회합니다 (nwvrqwxyaytdsfvhu)", runMember},
+	{"votes", "의안별 국회의원 본회의 표결정보를 조회합니다 (nojepdqqaweusdfbi)", runVotes},
+	{"conferences", "회의록 목록을 조회합니다 (VCONFBILLCONFLIST, VCONFPHCONFLIST)", runConferences},
+	{"history", "역대 국회의원 현황을 조회합니다 (nprlapfmaufmqytet)", runHistory},
+	{"download", "법안 PDF(bills)나 회의록 PDF(meetings)를 일괄 다운로드합니다", runDownload},
+}
+
+// errUsage는 잘못된 인자로 실행했음을 나타냅니다. 도움말은 flag 패키지가 이미 출력했으므로 종료 코드만 2로 바꿉니다.
+var errUsage = errors.New("usage error")
+
+// environment는 하위 명령이 공유하는 입출력입니다.
+type environment struct {
+	stdout io.Writer
+	stderr io.Writer
+}
+
+// run은 명령행 인자를 해석해 하위 명령을 실행하고 종료 코드를 반환합니다.
+func run(args []string, stdout, stderr io.Writer) int {
+	env := &environment{stdout: stdout, stderr: stderr}
+	if len(args) == 0 || args[0] == "-h" || args[0] == "-help" || args[0] == "--help" || args[0] == "help" {
+		usage(stderr)
+		if len(args) == 0 {
+			return 2
+		}
+		return 0
+	}
+
+	for _, cmd := range commands {
+		if cmd.name != args[0] {
+			continue
+		}
+		err := cmd.run(env, args[1:])
+		switch {
+		case err == nil:
+			return 0
+		case errors.Is(err, flag.ErrHelp):
+			return 0
+		case errors.Is(err, errUsage):
+			return 2
+		default:
+			fmt.Fprintf(stderr, "assembly %s: %v\n", cmd.name, err)
+			return 1
+		}
+	}
+
+	fmt.Fprintf(stderr, "assembly: 알 수 없는 명령 %q\n\n", args[0])
+	usage(stderr)
+	return 2
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "사용법: assembly <명령> [플래그]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "명령:")
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %-12s %s\n", cmd.name, cmd.summary)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "각 명령의 플래그는 'assembly <명령> -h'로 확인할 수 있습니다.")
+	fmt.Fprintln(w, "인증키는 APIKEY 또는 ASSEMBLY_API_KEY(S) 환경 변수, .env 파일, -config 설정 파일에서 읽습니다.")
+}
+
+// newClient는 configPath가 있으면 설정 파일로, 없으면 환경 변수로 Client를 생성합니다.
+func newClient(configPath string) (*assembly_go.Client, error) {
+	if configPath == "" {
+		return assembly_go.NewClientFromEnv()
+	}
+	cfg, err := assembly_go.LoadConfig(configPath)
+	if err != nil {
+		return nil, err
+	}
+	return assembly_go.NewClientFromConfig(cfg)
+}
+
+// commonFlags는 모든 조회 명령이 공유하는 플래그입니다.
+type commonFlags struct {
+	format   string
+	fields   string
+	limit    int
+	pageSize int
+	config   string
+	output   string
+}
+
+func (f *commonFlags) register(fs *flag.FlagSet) {
+	fs.StringVar(&f.format, "format", formatTable, "출력 형식: table, json, jsonl, csv")
+	fs.StringVar(&f.fields, "fields", "", "출력할 필드 목록 (쉼표로 구분한 응답 필드명, 예: BILL_NO,BILL_NAME)")
+	fs.StringVar(&f.output, "o", "", "결과를 저장할 파일 경로 (없으면 표준 출력)")
+	f.registerFetch(fs)
+}
+
+// registerFetch는 출력 형식을 제외한 조회 관련 플래그만 등록합니다. 결과를 표로 출력하지 않는 download가 사용합니다.
+func (f *commonFlags) registerFetch(fs *flag.FlagSet) {
+	fs.IntVar(&f.limit, "limit", 0, "최대 조회 건수 (0이면 전체)")
+	fs.IntVar(&f.pageSize, "page-size", 100, "페이지당 요청 건수 (최대 1000)")
+	fs.StringVar(&f.config, "config", "", "설정 파일 경로 (없으면 환경 변수와 .env 사용)")
+}
+
+// fieldList는 -fields 값을 필드 이름 목록으로 나눕니다.
+func (f *commonFlags) fieldList() []string {
+	if strings.TrimSpace(f.fields) == "" {
+		return nil
+	}
+	var fields []string
+	for _, field := range strings.Split(f.fields, ",") {
+		if field = strings.TrimSpace(field); field != "" {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+func (f *commonFlags) validate() error {
+	if f.format != "" && !validFormat(f.format) {
+		return fmt.Errorf("지원하지 않는 출력 형식 %q (table, json, jsonl, csv 중 하나)", f.format)
+	}
+	if f.pageSize < 1 || f.pageSize > maxPageSize {
+		return fmt.Errorf("-page-size는 1 이상 %d 이하여야 합니다: %d", maxPageSize, f.pageSize)
+	}
+	if f.limit < 0 {
+		return fmt.Errorf("-limit은 0 이상이어야 합니다: %d", f.limit)
+	}
+	return nil
+}
+
+// parseFlags는 fs로 args를 해석합니다. -h는 flag.ErrHelp, 잘못된 플래그는 errUsage로 돌려줍니다.
+// 플래그 뒤에 오는 위치 인자도 허용하기 위해 남은 인자를 반환합니다.
+func parseFlags(fs *flag.FlagSet, common *commonFlags, args []string) ([]string, error) {
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil, err
+		}
+		return nil, errUsage
+	}
+	if err := common.validate(); err != nil {
+		return nil, err
+	}
+	return fs.Args(), nil
+}
+
+// newFlagSet은 env.stderr로 도움말을 출력하는 FlagSet을 만듭니다.
+func newFlagSet(env *environment, name, synopsis string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(env.stderr)
+	fs.Usage = func() {
+		fmt.Fprintf(env.stderr, "사용법: assembly %s\n\n플래그:\n", synopsis)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// emit은 common의 -o 설정에 따라 출력 대상을 열어 rows를 씁니다.
+func emit[T any](env *environment, common *commonFlags, rows []T, layout tableLayout) error {
+	if common.output == "" {
+		return writeRows(env.stdout, common.format, rows, common.fieldList(), layout)
+	}
+
+	file, err := os.Create(common.output)
+	if err != nil {
+		return err
+	}
+	if err := writeRows(file, common.format, rows, common.fieldList(), layout); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	fmt.Fprintf(env.stderr, "%d건을 %s에 저장했습니다.\n", len(rows), common.output)
+	return nil
+}
@@ -0,0 +1,259 @@
+package main
+
+import (
+	"assembly_go/assemblytest"
+	"assembly_go/models"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// setupCLI는 가짜 OpenAPI 서버를 띄우고, CLI가 환경 변수로 그 서버에 접속하도록 설정합니다.
+func setupCLI(t *testing.T) *assemblytest.Server {
+	t.Helper()
+	srv := assemblytest.NewServer()
+	t.Cleanup(srv.Close)
+	t.Chdir(t.TempDir()) // 작업 디렉터리의 .env를 읽지 않도록 합니다.
+	t.Setenv("APIKEY", "")
+	t.Setenv("ASSEMBLY_API_KEY", assemblytest.DefaultAPIKey)
+	t.Setenv("ASSEMBLY_BASE_URL", srv.URL)
+	return srv
+}
+
+// runCLI는 args로 CLI를 실행하고 종료 코드와 표준 출력, 표준 에러를 반환합니다.
+func runCLI(args ...string) (int, string, string) {
+	var stdout, stderr bytes.Buffer
+	code := run(args, &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+func addBills(srv *assemblytest.Server, n int) {
+	for i := 1; i <= n; i++ {
+		srv.AddBills(models.TVBPMBILL11Row{
+			BillId:     fmt.Sprintf("PRC_%03d", i),
+			BillNumber: fmt.Sprintf("22%05d", i),
+			Age:        "22",
+			BillName:   fmt.Sprintf("국회법 일부개정법률안 %d", i),
+			Proposer:   "홍길동의원 등 10인",
+		})
+	}
+}
+
+func TestCLIPagination(t *testing.T) {
+	t.Run("전체 페이지 조회", func(t *testing.T) {
+		srv := setupCLI(t)
+		addBills(srv, 25)
+
+		code, stdout, stderr := runCLI("bills", "-age", "22", "-page-size", "10", "-format", "jsonl")
+		if code != 0 {
+			t.Fatalf("종료 코드 기대값: 0, 결과값: %d (%s)", code, stderr)
+		}
+		lines := strings.Split(strings.TrimSpace(stdout), "\n")
+		if len(lines) != 25 {
+			t.Errorf("기대값: 25줄, 결과값: %d줄", len(lines))
+		}
+		if got := srv.RequestCount(assemblytest.EndpointBills); got != 3 {
+			t.Errorf("요청 수 기대값: 3, 결과값: %d", got)
+		}
+
+		var first map[string]any
+		if err := json.Unmarshal([]byte(lines[0]), &first); err != nil {
+			t.Fatalf("JSONL 파싱 실패: %v", err)
+		}
+		if first["BILL_ID"] != "PRC_001" {
+			t.Errorf("기대값: PRC_001, 결과값: %v", first["BILL_ID"])
+		}
+	})
+
+	t.Run("limit에 도달하면 중단", func(t *testing.T) {
+		srv := setupCLI(t)
+		addBills(srv, 25)
+
+		code, stdout, _ := runCLI("bills", "-page-size", "10", "-limit", "12", "-format", "jsonl")
+		if code != 0 {
+			t.Fatalf("종료 코드 기대값: 0, 결과값: %d", code)
+		}
+		if got := strings.Count(stdout, "\n"); got != 12 {
+			t.Errorf("기대값: 12줄, 결과값: %d줄", got)
+		}
+		if got := srv.RequestCount(assemblytest.EndpointBills); got != 2 {
+			t.Errorf("요청 수 기대값: 2, 결과값: %d", got)
+		}
+	})
+
+	t.Run("선택 인자 필터", func(t *testing.T) {
+		srv := setupCLI(t)
+		addBills(srv, 5)
+
+		code, stdout, _ := runCLI("bills", "-name", "국회법 일부개정법률안 3", "-format", "json")
+		if code != 0 {
+			t.Fatalf("종료 코드 기대값: 0, 결과값: %d", code)
+		}
+		var rows []map[string]any
+		if err := json.Unmarshal([]byte(stdout), &rows); err != nil {
+			t.Fatalf("JSON 파싱 실패: %v", err)
+		}
+		if len(rows) != 1 || rows[0]["BILL_ID"] != "PRC_003" {
+			t.Errorf("기대값: PRC_003 1건, 결과값: %v", rows)
+		}
+		if got := srv.Requests()[0].Query.Get("BILL_NO"); got != "" {
+			t.Errorf("빈 선택 인자는 보내지 않아야 합니다: %q", got)
+		}
+	})
+
+	t.Run("회의록 선택 인자 필터", func(t *testing.T) {
+		srv := setupCLI(t)
+		srv.AddConferences(
+			models.VCONFPHCONFLISTRow{CONF_ID: "C1", ERACO: "제22대", SESS: "제415회", CONF_DT: "2024-06-05"},
+			models.VCONFPHCONFLISTRow{CONF_ID: "C2", ERACO: "제22대", SESS: "제416회", CONF_DT: "2024-07-01"},
+		)
+		srv.AddBillConferences(
+			models.VCONFBILLCONFLISTRow{BillId: "PRC_A", ConferenceId: "C1", ConferenceDate: "2024-06-05"},
+			models.VCONFBILLCONFLISTRow{BillId: "PRC_A", ConferenceId: "C2", ConferenceDate: "2024-07-01"},
+		)
+
+		for _, args := range [][]string{
+			{"conferences", "-era", "제22대", "-date", "2024-07-01", "-format", "jsonl"},
+			{"conferences", "-era", "제22대", "-session", "제416회", "-format", "jsonl"},
+			{"conferences", "-bill-id", "PRC_A", "-date", "2024-07-01", "-format", "jsonl"},
+		} {
+			code, stdout, stderr := runCLI(args...)
+			if code != 0 {
+				t.Fatalf("%v 종료 코드 기대값: 0, 결과값: %d (%s)", args, code, stderr)
+			}
+			if lines := strings.Split(strings.TrimSpace(stdout), "\n"); len(lines) != 1 || !strings.Contains(lines[0], `"C2"`) {
+				t.Errorf("%v 기대값: C2 1건, 결과값: %q", args, stdout)
+			}
+		}
+	})
+}
+
+func TestCLIOutputFormats(t *testing.T) {
+	t.Run("CSV 필드 선택", func(t *testing.T) {
+		srv := setupCLI(t)
+		addBills(srv, 2)
+
+		code, stdout, _ := runCLI("bills", "-format", "csv", "-fields", "BILL_NO,bill_name")
+		if code != 0 {
+			t.Fatalf("종료 코드 기대값: 0, 결과값: %d", code)
+		}
+		records, err := csv.NewReader(strings.NewReader(stdout)).ReadAll()
+		if err != nil {
+			t.Fatalf("CSV 파싱 실패: %v", err)
+		}
+		want := [][]string{{"BILL_NO", "BILL_NAME"}, {"2200001", "국회법 일부개정법률안 1"}, {"2200002", "국회법 일부개정법률안 2"}}
+		if fmt.Sprint(records) != fmt.Sprint(want) {
+			t.Errorf("기대값: %v, 결과값: %v", want, records)
+		}
+	})
+
+	t.Run("표의 열은 한글 너비에 맞춰 정렬", func(t *testing.T) {
+		srv := setupCLI(t)
+		addBills(srv, 2)
+		srv.AddBills(models.TVBPMBILL11Row{BillNumber: "2200003", BillName: "법", Proposer: "정부"})
+
+		code, stdout, _ := runCLI("bills", "-fields", "BILL_NAME,PROPOSER")
+		if code != 0 {
+			t.Fatalf("종료 코드 기대값: 0, 결과값: %d", code)
+		}
+		lines := strings.Split(strings.TrimRight(stdout, "\n"), "\n")
+		if len(lines) != 4 {
+			t.Fatalf("기대값: 4줄, 결과값: %d줄\n%s", len(lines), stdout)
+		}
+		column := displayWidth(lines[1][:strings.Index(lines[1], "홍길동")])
+		for _, line := range lines[2:] {
+			idx := strings.Index(line, "정부")
+			if idx < 0 {
+				idx = strings.Index(line, "홍길동")
+			}
+			if got := displayWidth(line[:idx]); got != column {
+				t.Errorf("열 위치 기대값: %d, 결과값: %d\n%s", column, got, stdout)
+			}
+		}
+	})
+
+	t.Run("member는 세로로 출력", func(t *testing.T) {
+		srv := setupCLI(t)
+		srv.AddMemberDetails(
+			models.NwvrqwxyaytdsfvhuRow{HgNm: "홍길동", MonaCd: "ABC123"},
+			models.NwvrqwxyaytdsfvhuRow{HgNm: "김철수", MonaCd: "DEF456"},
+		)
+
+		code, stdout, stderr := runCLI("member", "홍길동")
+		if code != 0 {
+			t.Fatalf("종료 코드 기대값: 0, 결과값: %d (%s)", code, stderr)
+		}
+		if !strings.Contains(stdout, "\nMONA_CD       ABC123\n") || !strings.Contains(stdout, "\nHJ_NM\n") || strings.Contains(stdout, "DEF456") {
+			t.Errorf("홍길동의 인적사항만 세로로 출력되어야 합니다:\n%s", stdout)
+		}
+	})
+
+	t.Run("파일로 저장", func(t *testing.T) {
+		srv := setupCLI(t)
+		addBills(srv, 3)
+		path := filepath.Join(t.TempDir(), "bills.jsonl")
+
+		code, stdout, _ := runCLI("bills", "-format", "jsonl", "-o", path)
+		if code != 0 || stdout != "" {
+			t.Fatalf("종료 코드 기대값: 0, 결과값: %d, 표준 출력: %q", code, stdout)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := strings.Count(string(data), "\n"); got != 3 {
+			t.Errorf("기대값: 3줄, 결과값: %d줄", got)
+		}
+	})
+}
+
+func TestCLIErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		code int
+		want string
+	}{
+		{"알 수 없는 명령", []string{"bill"}, 2, "알 수 없는 명령"},
+		{"필수 플래그 누락", []string{"votes", "-age", "22"}, 2, "-bill-id"},
+		{"잘못된 출력 형식", []string{"bills", "-format", "xml"}, 1, "지원하지 않는 출력 형식"},
+		{"알 수 없는 필드", []string{"bills", "-fields", "NOPE"}, 1, "알 수 없는 필드"},
+		{"member 인자 누락", []string{"member"}, 2, "이름 또는 -code"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setupCLI(t)
+			code, _, stderr := runCLI(tt.args...)
+			if code != tt.code {
+				t.Errorf("종료 코드 기대값: %d, 결과값: %d", tt.code, code)
+			}
+			if !strings.Contains(stderr, tt.want) {
+				t.Errorf("에러 메시지에 %q가 없습니다: %s", tt.want, stderr)
+			}
+		})
+	}
+
+	t.Run("RESULT 오류 응답", func(t *testing.T) {
+		srv := setupCLI(t)
+		addBills(srv, 3)
+		srv.InjectFault(assemblytest.Fault{Path: assemblytest.EndpointBills, ResultCode: "ERROR-500"})
+		code, stdout, stderr := runCLI("bills", "-age", "22")
+		if code != 1 || stdout != "" || !strings.Contains(stderr, "ERROR-500") {
+			t.Errorf("종료 코드 기대값: 1, 결과값: %d (%s)", code, stderr)
+		}
+	})
+
+	t.Run("인증키 없음", func(t *testing.T) {
+		setupCLI(t)
+		t.Setenv("ASSEMBLY_API_KEY", "")
+		code, _, stderr := runCLI("bills")
+		if code != 1 || !strings.Contains(stderr, "api_key") {
+			t.Errorf("종료 코드 기대값: 1, 결과값: %d (%s)", code, stderr)
+		}
+	})
+}
@@ -0,0 +1,329 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// 출력 형식입니다.
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+	formatJSONL = "jsonl"
+	formatCSV   = "csv"
+)
+
+func validFormat(format string) bool {
+	switch format {
+	case formatTable, formatJSON, formatJSONL, formatCSV:
+		return true
+	}
+	return false
+}
+
+// maxCellWidth는 table 형식에서 한 칸에 표시할 최대 너비입니다. 더 긴 값은 "…"로 줄입니다.
+const maxCellWidth = 40
+
+// tableLayout은 table 형식의 기본 모양입니다.
+type tableLayout struct {
+	fields   []string // -fields가 없을 때 표시할 필드 (nil이면 전체)
+	vertical bool     // 행마다 "필드: 값" 목록으로 세로 출력
+}
+
+// column은 응답 행 구조체의 필드 하나입니다. name은 응답 JSON 필드명입니다.
+type column struct {
+	name  string
+	index int
+}
+
+// columnsOf는 행 타입의 JSON 필드 목록을 선언 순서대로 반환합니다.
+func columnsOf(rowType reflect.Type) []column {
+	var columns []column
+	for i := 0; i < rowType.NumField(); i++ {
+		field := rowType.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if !field.IsExported() || name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		columns = append(columns, column{name: name, index: i})
+	}
+	return columns
+}
+
+// selectColumns는 fields 순서대로 열을 고릅니다. fields가 비어 있으면 전체 열을 반환합니다.
+func selectColumns(all []column, fields []string) ([]column, error) {
+	if len(fields) == 0 {
+		return all, nil
+	}
+	byName := make(map[string]column, len(all))
+	for _, c := range all {
+		byName[strings.ToUpper(c.name)] = c
+	}
+	selected := make([]column, 0, len(fields))
+	for _, field := range fields {
+		c, ok := byName[strings.ToUpper(field)]
+		if !ok {
+			names := make([]string, len(all))
+			for i, c := range all {
+				names[i] = c.name
+			}
+			return nil, fmt.Errorf("알 수 없는 필드 %q (사용 가능: %s)", field, strings.Join(names, ", "))
+		}
+		selected = append(selected, c)
+	}
+	return selected, nil
+}
+
+// cellValue는 필드 값을 JSON에 쓸 값으로 반환합니다. nil 포인터와 빈 json.Number는 nil입니다.
+func cellValue(v reflect.Value) any {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if number, ok := v.Interface().(json.Number); ok && number == "" {
+		return nil // 포털이 빈 문자열로 보낸 숫자 필드
+	}
+	return v.Interface()
+}
+
+// cellText는 필드 값을 CSV, table에 쓸 문자열로 반환합니다. nil 포인터는 빈 문자열입니다.
+func cellText(v reflect.Value) string {
+	value := cellValue(v)
+	if value == nil {
+		return ""
+	}
+	return fmt.Sprint(value)
+}
+
+// writeRows는 rows를 format 형식으로 w에 씁니다.
+// fields가 있으면 그 필드만, 없으면 json/jsonl/csv는 전체 필드를, table은 layout.fields를 출력합니다.
+func writeRows[T any](w io.Writer, format string, rows []T, fields []string, layout tableLayout) error {
+	all := columnsOf(reflect.TypeOf((*T)(nil)).Elem())
+	if len(fields) == 0 && format == formatTable {
+		fields = layout.fields
+	}
+	columns, err := selectColumns(all, fields)
+	if err != nil {
+		return err
+	}
+
+	values := make([]reflect.Value, len(rows))
+	for i := range rows {
+		values[i] = reflect.ValueOf(rows[i])
+	}
+
+	bw := bufio.NewWriter(w)
+	switch format {
+	case formatJSON:
+		err = writeJSON(bw, columns, values)
+	case formatJSONL:
+		err = writeJSONL(bw, columns, values)
+	case formatCSV:
+		err = writeCSV(bw, columns, values)
+	default:
+		if layout.vertical {
+			err = writeVertical(bw, columns, values)
+		} else {
+			err = writeTable(bw, columns, values)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// encodeRecord는 열 순서를 유지한 JSON 객체 하나를 만듭니다.
+func encodeRecord(columns []column, row reflect.Value) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, c := range columns {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		name, _ := json.Marshal(c.name)
+		value, err := json.Marshal(cellValue(row.Field(c.index)))
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+func writeJSON(w io.Writer, columns []column, rows []reflect.Value) error {
+	records := make([]json.RawMessage, len(rows))
+	for i, row := range rows {
+		record, err := encodeRecord(columns, row)
+		if err != nil {
+			return err
+		}
+		records[i] = record
+	}
+	data, err := json.MarshalIndent(records, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s\n", data)
+	return err
+}
+
+func writeJSONL(w io.Writer, columns []column, rows []reflect.Value) error {
+	for _, row := range rows {
+		record, err := encodeRecord(columns, row)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(w, "%s\n", record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeCSV(w io.Writer, columns []column, rows []reflect.Value) error {
+	cw := csv.NewWriter(w)
+	header := make([]string, len(columns))
+	for i, c := range columns {
+		header[i] = c.name
+	}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		record := make([]string, len(columns))
+		for i, c := range columns {
+			record[i] = cellText(row.Field(c.index))
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// writeTable은 한글 등 전각 문자의 너비를 고려해 열을 맞춘 표를 씁니다.
+// text/tabwriter는 모든 문자의 너비를 같게 보므로 한글이 섞이면 열이 어긋납니다.
+func writeTable(w io.Writer, columns []column, rows []reflect.Value) error {
+	cells := make([][]string, 0, len(rows)+1)
+	header := make([]string, len(columns))
+	for i, c := range columns {
+		header[i] = c.name
+	}
+	cells = append(cells, header)
+	for _, row := range rows {
+		record := make([]string, len(columns))
+		for i, c := range columns {
+			record[i] = truncate(singleLine(cellText(row.Field(c.index))), maxCellWidth)
+		}
+		cells = append(cells, record)
+	}
+
+	widths := make([]int, len(columns))
+	for _, record := range cells {
+		for i, cell := range record {
+			widths[i] = max(widths[i], displayWidth(cell))
+		}
+	}
+
+	for _, record := range cells {
+		var line strings.Builder
+		for i, cell := range record {
+			line.WriteString(cell)
+			if i < len(record)-1 {
+				line.WriteString(strings.Repeat(" ", widths[i]-displayWidth(cell)+2))
+			}
+		}
+		if _, err := fmt.Fprintln(w, line.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeVertical은 행마다 "필드  값" 목록을 쓰고, 행 사이에 빈 줄을 넣습니다.
+func writeVertical(w io.Writer, columns []column, rows []reflect.Value) error {
+	width := 0
+	for _, c := range columns {
+		width = max(width, displayWidth(c.name))
+	}
+	for n, row := range rows {
+		if n > 0 {
+			if _, err := fmt.Fprintln(w); err != nil {
+				return err
+			}
+		}
+		for _, c := range columns {
+			text := singleLine(cellText(row.Field(c.index)))
+			line := fmt.Sprintf("%-*s  %s", width, c.name, text)
+			if _, err := fmt.Fprintln(w, strings.TrimRight(line, " ")); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// singleLine은 표 한 칸에 들어가도록 줄바꿈을 공백으로 바꿉니다.
+func singleLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// truncate는 표시 너비가 limit를 넘으면 잘라내고 "…"를 붙입니다.
+func truncate(s string, limit int) string {
+	if displayWidth(s) <= limit {
+		return s
+	}
+	var b strings.Builder
+	width := 0
+	for _, r := range s {
+		rw := runeWidth(r)
+		if width+rw > limit-1 {
+			break
+		}
+		b.WriteRune(r)
+		width += rw
+	}
+	b.WriteString("…")
+	return b.String()
+}
+
+// displayWidth는 터미널에 표시될 때의 너비를 계산합니다.
+func displayWidth(s string) int {
+	width := 0
+	for _, r := range s {
+		width += runeWidth(r)
+	}
+	return width
+}
+
+// runeWidth는 한글, 한자, 전각 기호를 2칸, 그 밖의 문자를 1칸으로 봅니다.
+func runeWidth(r rune) int {
+	switch {
+	case r == utf8.RuneError || unicode.Is(unicode.Mn, r):
+		return 0
+	case unicode.Is(unicode.Hangul, r), unicode.Is(unicode.Han, r),
+		r >= 0x3000 && r <= 0x303F, // CJK 기호 및 구두점
+		r >= 0xFF01 && r <= 0xFF60, // 전각 문자
+		r >= 0xFFE0 && r <= 0xFFE6:
+		return 2
+	}
+	return 1
+}
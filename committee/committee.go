@@ -0,0 +1,310 @@
+// Package committee는 OpenAPI 행마다 다르게 적힌 위원회 코드와 이름을 하나의 Committee로 맞춥니다.
+//
+// 위원회는 법안(CURR_COMMITTEE_ID, CURR_COMMITTEE), 회의록(CMIT_CD, CMIT_NM), 의원 정보(CMIT_NM, BLNG_CMIT_NM, CMITS)에
+// 코드나 이름으로 나오고, 같은 위원회라도 대수마다 이름이 바뀝니다 (예: 국토해양위원회 → 국토교통위원회).
+// Registry는 현재 상임위원회와 그 옛 이름을 내장하고, 행을 읽으면서 코드와 새 이름을 익힙니다.
+//
+//	reg := committee.NewRegistry()
+//	reg.ObserveBills(bills...)
+//	c, _ := reg.Lookup("국토해양위원회") // 국토교통위원회
+//	rows := reg.FilterBills(bills, c)
+package committee
+
+import (
+	"assembly_go/models"
+	"slices"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// Kind는 위원회 종류입니다.
+type Kind string
+
+const (
+	KindStanding Kind = "standing" // 상임위원회
+	KindSpecial  Kind = "special"  // 특별위원회
+	KindPlenary  Kind = "plenary"  // 본회의
+	KindOther    Kind = "other"    // 내장 목록에 없어 행에서 처음 본 위원회
+)
+
+// Committee는 정규화한 위원회 하나입니다. Name이 기준 이름이며 같은 위원회인지는 Name으로 비교합니다.
+type Committee struct {
+	Name    string   `json:"name"`              // 현재(또는 마지막) 이름
+	Short   string   `json:"short,omitempty"`   // 약칭 (예: 법사위)
+	Kind    Kind     `json:"kind"`              // 종류
+	Aliases []string `json:"aliases,omitempty"` // 옛 이름과 다른 표기
+	Codes   []string `json:"codes,omitempty"`   // 행에서 본 위원회 코드
+}
+
+// IsZero는 c가 빈 값(찾지 못함)인지 알려줍니다.
+func (c Committee) IsZero() bool {
+	return c.Name == ""
+}
+
+func (c Committee) String() string {
+	return c.Name
+}
+
+// builtin은 제22대 국회의 상임위원회와 주요 특별위원회, 그리고 제16대 이후의 옛 이름입니다.
+// 둘 이상의 위원회로 나뉜 옛 위원회(예: 교육문화체육관광위원회, 교육과학기술위원회, 문화체육관광방송통신위원회)는 한쪽으로 정할 수 없어 넣지 않았습니다.
+var builtin = []Committee{
+	{Name: "본회의", Kind: KindPlenary},
+	{Name: "국회운영위원회", Short: "운영위", Kind: KindStanding, Aliases: []string{"운영위원회"}},
+	{Name: "법제사법위원회", Short: "법사위", Kind: KindStanding},
+	{Name: "정무위원회", Short: "정무위", Kind: KindStanding},
+	{Name: "기획재정위원회", Short: "기재위", Kind: KindStanding, Aliases: []string{"재정경제위원회"}},
+	{Name: "교육위원회", Short: "교육위", Kind: KindStanding},
+	{Name: "과학기술정보방송통신위원회", Short: "과방위", Kind: KindStanding, Aliases: []string{"미래창조과학방송통신위원회", "과학기술정보통신위원회"}},
+	{Name: "외교통일위원회", Short: "외통위", Kind: KindStanding, Aliases: []string{"외교통상통일위원회", "통일외교통상위원회"}},
+	{Name: "국방위원회", Short: "국방위", Kind: KindStanding},
+	{Name: "행정안전위원회", Short: "행안위", Kind: KindStanding, Aliases: []string{"안전행정위원회", "행정자치위원회"}},
+	{Name: "문화체육관광위원회", Short: "문체위", Kind: KindStanding, Aliases: []string{"문화관광위원회"}},
+	{Name: "농림축산식품해양수산위원회", Short: "농해수위", Kind: KindStanding, Aliases: []string{"농림수산식품위원회", "농림해양수산위원회"}},
+	{Name: "산업통상자원중소벤처기업위원회", Short: "산자위", Kind: KindStanding, Aliases: []string{"산업통상자원위원회", "지식경제위원회", "산업자원위원회", "산자중기위"}},
+	{Name: "보건복지위원회", Short: "복지위", Kind: KindStanding, Aliases: []string{"보건복지가족위원회"}},
+	{Name: "환경노동위원회", Short: "환노위", Kind: KindStanding},
+	{Name: "국토교통위원회", Short: "국토위", Kind: KindStanding, Aliases: []string{"국토해양위원회", "건설교통위원회"}},
+	{Name: "정보위원회", Short: "정보위", Kind: KindStanding},
+	{Name: "여성가족위원회", Short: "여가위", Kind: KindStanding, Aliases: []string{"여성위원회"}},
+	{Name: "예산결산특별위원회", Short: "예결위", Kind: KindSpecial},
+	{Name: "윤리특별위원회", Short: "윤리특위", Kind: KindSpecial},
+}
+
+// Registry는 위원회 목록과 이름, 코드 색인입니다. 여러 고루틴에서 함께 써도 안전합니다.
+// 0값은 쓸 수 없으므로 NewRegistry로 만드십시오.
+type Registry struct {
+	mu     sync.RWMutex
+	list   []*Committee
+	byName map[string]*Committee // key(이름, 약칭, 옛 이름) → 위원회
+	byCode map[string]*Committee
+}
+
+// NewRegistry는 내장 위원회 목록을 담은 Registry를 만듭니다.
+func NewRegistry() *Registry {
+	r := &Registry{byName: make(map[string]*Committee), byCode: make(map[string]*Committee)}
+	for _, c := range builtin {
+		c.Aliases = slices.Clone(c.Aliases)
+		r.add(&c)
+	}
+	return r
+}
+
+// add는 잠금을 잡은 상태에서 c를 목록과 색인에 넣습니다.
+func (r *Registry) add(c *Committee) {
+	r.list = append(r.list, c)
+	for _, name := range append([]string{c.Name, c.Short}, c.Aliases...) {
+		if k := key(name); k != "" {
+			r.byName[k] = c
+		}
+	}
+	for _, code := range c.Codes {
+		r.byCode[code] = c
+	}
+}
+
+// key는 이름 비교에 쓰는 키입니다. 공백과 가운뎃점을 지웁니다.
+func key(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || r == '·' || r == 'ㆍ' {
+			return -1
+		}
+		return r
+	}, name)
+}
+
+// Register는 위원회를 추가하거나, 같은 이름의 위원회가 있으면 약칭, 옛 이름, 코드를 더합니다.
+// 내장 목록에 없는 특별위원회나 옛 위원회를 미리 알려줄 때 씁니다.
+func (r *Registry) Register(c Committee) Committee {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	existing := r.byName[key(c.Name)]
+	if existing == nil {
+		if c.Kind == "" {
+			c.Kind = KindOther
+		}
+		c.Aliases = slices.Clone(c.Aliases)
+		c.Codes = slices.Clone(c.Codes)
+		r.add(&c)
+		return clone(&c)
+	}
+	if existing.Short == "" && c.Short != "" && r.byName[key(c.Short)] == nil {
+		existing.Short = c.Short
+		r.byName[key(c.Short)] = existing
+	}
+	for _, alias := range c.Aliases {
+		r.addAlias(existing, alias)
+	}
+	for _, code := range c.Codes {
+		r.addCode(existing, code)
+	}
+	return clone(existing)
+}
+
+func (r *Registry) addAlias(c *Committee, name string) {
+	k := key(name)
+	if k == "" || r.byName[k] != nil {
+		return // 다른 위원회의 이름은 가져오지 않습니다.
+	}
+	c.Aliases = append(c.Aliases, strings.TrimSpace(name))
+	r.byName[k] = c
+}
+
+func (r *Registry) addCode(c *Committee, code string) {
+	if code == "" || r.byCode[code] == c {
+		return
+	}
+	c.Codes = append(c.Codes, code)
+	r.byCode[code] = c
+}
+
+func clone(c *Committee) Committee {
+	out := *c
+	out.Aliases = slices.Clone(c.Aliases)
+	out.Codes = slices.Clone(c.Codes)
+	return out
+}
+
+// All은 등록된 위원회를 등록 순서대로 반환합니다.
+func (r *Registry) All() []Committee {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	out := make([]Committee, len(r.list))
+	for i, c := range r.list {
+		out[i] = clone(c)
+	}
+	return out
+}
+
+// Lookup은 위원회 코드, 이름, 약칭, 옛 이름 중 무엇으로든 위원회를 찾습니다.
+func (r *Registry) Lookup(codeOrName string) (Committee, bool) {
+	codeOrName = strings.TrimSpace(codeOrName)
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if c := r.byCode[codeOrName]; c != nil {
+		return clone(c), true
+	}
+	if c := r.byName[key(codeOrName)]; c != nil {
+		return clone(c), true
+	}
+	return Committee{}, false
+}
+
+// Observe는 행에서 본 코드와 이름 한 쌍으로 위원회를 찾고 Registry에 익힙니다.
+//   - 코드를 이미 알고 이름이 처음 보는 것이면 그 이름을 옛 이름(Aliases)으로 더합니다 (대수가 바뀌며 이름만 바뀐 경우).
+//   - 이름을 알고 코드가 처음 보는 것이면 코드를 더합니다.
+//   - 둘 다 처음 보면 KindOther 위원회로 새로 등록합니다.
+//
+// 코드와 이름이 모두 비어있으면 false를 반환합니다.
+func (r *Registry) Observe(code, name string) (Committee, bool) {
+	code, name = strings.TrimSpace(code), strings.TrimSpace(name)
+	if code == "" && key(name) == "" {
+		return Committee{}, false
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c := r.byCode[code]
+	if c == nil {
+		c = r.byName[key(name)]
+	}
+	if c == nil {
+		if key(name) == "" {
+			return Committee{}, false // 처음 보는 코드만으로는 위원회를 만들지 않습니다.
+		}
+		c = &Committee{Name: name, Kind: KindOther}
+		r.add(c)
+	}
+	r.addCode(c, code)
+	if name != "" {
+		r.addAlias(c, name)
+	}
+	return clone(c), true
+}
+
+// ObserveBills는 법안 행의 소관위 코드와 이름(CURR_COMMITTEE_ID, CURR_COMMITTEE)을 익힙니다.
+func (r *Registry) ObserveBills(rows ...models.TVBPMBILL11Row) {
+	for _, row := range rows {
+		r.Observe(row.JurisdictionCommitteeCode, row.JurisdictionCommittee)
+	}
+}
+
+// ObserveMeetings는 회의록 행의 위원회 코드와 이름(CMIT_CD, CMIT_NM)을 익힙니다.
+func (r *Registry) ObserveMeetings(rows ...models.VCONFPHCONFLISTRow) {
+	for _, row := range rows {
+		r.Observe(row.CMIT_CD, row.CMIT_NM)
+	}
+}
+
+// Bill은 법안의 소관위원회를 찾습니다 (Observe처럼 처음 보는 코드와 이름을 익힙니다). 소관위가 정해지지 않은 법안은 false입니다.
+func (r *Registry) Bill(row models.TVBPMBILL11Row) (Committee, bool) {
+	return r.Observe(row.JurisdictionCommitteeCode, row.JurisdictionCommittee)
+}
+
+// Meeting은 회의록의 위원회를 찾습니다.
+func (r *Registry) Meeting(row models.VCONFPHCONFLISTRow) (Committee, bool) {
+	return r.Observe(row.CMIT_CD, row.CMIT_NM)
+}
+
+// FilterBills는 소관위원회가 c인 법안만 순서대로 반환합니다.
+func (r *Registry) FilterBills(rows []models.TVBPMBILL11Row, c Committee) []models.TVBPMBILL11Row {
+	var out []models.TVBPMBILL11Row
+	for _, row := range rows {
+		if got, ok := r.Bill(row); ok && got.Name == c.Name {
+			out = append(out, row)
+		}
+	}
+	return out
+}
+
+// FilterMeetings는 위원회가 c인 회의록만 순서대로 반환합니다.
+func (r *Registry) FilterMeetings(rows []models.VCONFPHCONFLISTRow, c Committee) []models.VCONFPHCONFLISTRow {
+	var out []models.VCONFPHCONFLISTRow
+	for _, row := range rows {
+		if got, ok := r.Meeting(row); ok && got.Name == c.Name {
+			out = append(out, row)
+		}
+	}
+	return out
+}
+
+// ParseList는 CMITS, BLNG_CMIT_NM처럼 쉼표로 구분한 위원회 목록을 나눕니다. 빈 항목과 앞뒤 공백은 버립니다.
+func ParseList(s string) []string {
+	var out []string
+	for _, part := range strings.Split(s, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			out = append(out, part)
+		}
+	}
+	return out
+}
+
+// ResolveList는 쉼표로 구분한 위원회 목록을 위원회로 바꿉니다. 같은 위원회는 한 번만 넣으며,
+// 목록에 처음 보는 이름이 있으면 Observe처럼 새로 등록합니다.
+func (r *Registry) ResolveList(s string) []Committee {
+	var out []Committee
+	for _, name := range ParseList(s) {
+		c, ok := r.Observe("", name)
+		if !ok || slices.ContainsFunc(out, func(o Committee) bool { return o.Name == c.Name }) {
+			continue
+		}
+		out = append(out, c)
+	}
+	return out
+}
+
+// Member는 의원 인적사항 행의 대표 위원회(CMIT_NM)와 소속 위원회 목록(CMITS)을 합쳐 반환합니다. 대표 위원회가 앞에 옵니다.
+func (r *Registry) Member(row models.NwvrqwxyaytdsfvhuRow) []Committee {
+	return r.ResolveList(deref(row.CmitNm) + "," + deref(row.Cmits))
+}
+
+// AllNameMember는 국회의원 정보 통합 행의 위원회(CMIT_NM)와 소속 위원회(BLNG_CMIT_NM)를 합쳐 반환합니다.
+func (r *Registry) AllNameMember(row models.AllNameMemberRow) []Committee {
+	return r.ResolveList(row.CmitNm + "," + row.BlngCmitNm)
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,411 @@
+package assembly_go
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Config는 환경 변수나 설정 파일에서 읽은 Client 설정입니다.
+// 0 값인 항목은 NewClient의 기본값을 그대로 사용합니다.
+type Config struct {
+	APIKeys         []string      // 인증키 목록 (여러 개면 순서대로 전환하며 사용)
+	APIKeysFile     string        // 인증키 목록 파일 경로 (LoadAPIKeysFile 형식)
+	BaseURL         string        // OpenAPI 기본 URL
+	FileBaseURL     string        // 법안 PDF filegate 기본 URL
+	Timeout         time.Duration // 요청 타임아웃
+	ConnectTimeout  time.Duration // 연결(TCP, TLS 핸드셰이크) 타임아웃
+	DownloadTimeout time.Duration // PDF 다운로드 전체 타임아웃
+	ProxyURL        string        // 프록시 URL
+	Retries         int           // 다운로드 재시도 횟수
+	RateLimit       float64       // 초당 최대 요청 수
+	CacheDir        string        // 조건부 다운로드 검증자 등을 저장할 캐시 디렉터리
+	UserAgent       string        // User-Agent 헤더 값
+}
+
+// ConfigError는 설정에 빠졌거나 잘못된 항목이 있을 때 반환됩니다.
+// errors.Is(err, ErrInvalidConfig)로 확인할 수 있습니다.
+type ConfigError struct {
+	Source  string   // 설정을 읽은 위치 (파일 경로 또는 "environment")
+	Missing []string // 필수인데 빠진 항목
+	Invalid []string // 값이 잘못된 항목과 그 이유
+}
+
+func (e *ConfigError) Error() string {
+	var parts []string
+	if len(e.Missing) > 0 {
+		parts = append(parts, "missing: "+strings.Join(e.Missing, ", "))
+	}
+	if len(e.Invalid) > 0 {
+		parts = append(parts, "invalid: "+strings.Join(e.Invalid, "; "))
+	}
+	return fmt.Sprintf("%v (%s): %s", ErrInvalidConfig, e.Source, strings.Join(parts, "; "))
+}
+
+func (e *ConfigError) Unwrap() error {
+	return ErrInvalidConfig
+}
+
+// 설정 항목 이름입니다. 설정 파일에서는 이 이름을, 환경 변수에서는 "ASSEMBLY_" 접두사를 붙인 대문자 이름을 사용합니다.
+// 예: api_keys = ASSEMBLY_API_KEYS, timeout = ASSEMBLY_TIMEOUT
+const (
+	configAPIKey          = "api_key"
+	configAPIKeys         = "api_keys"
+	configAPIKeysFile     = "api_keys_file"
+	configBaseURL         = "base_url"
+	configFileBaseURL     = "file_base_url"
+	configTimeout         = "timeout"
+	configConnectTimeout  = "connect_timeout"
+	configDownloadTimeout = "download_timeout"
+	configProxyURL        = "proxy_url"
+	configRetries         = "retries"
+	configRateLimit       = "rate_limit"
+	configCacheDir        = "cache_dir"
+	configUserAgent       = "user_agent"
+)
+
+var configKeys = []string{
+	configAPIKey, configAPIKeys, configAPIKeysFile, configBaseURL, configFileBaseURL,
+	configTimeout, configConnectTimeout, configDownloadTimeout, configProxyURL,
+	configRetries, configRateLimit, configCacheDir, configUserAgent,
+}
+
+// envPrefix는 환경 변수 이름 접두사입니다. .env.example의 APIKEY도 api_key로 인식합니다.
+const envPrefix = "ASSEMBLY_"
+
+// LoadConfig는 path의 설정 파일을 읽어 Config를 만듭니다.
+// 확장자가 .json이면 JSON, .toml이면 TOML 형식의 "key = value" 줄, 그 밖에는 .env 형식으로 해석합니다.
+// 빠졌거나 잘못된 항목, 알 수 없는 항목이 있으면 모두 나열한 *ConfigError를 반환합니다.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidConfig, err)
+	}
+
+	var values map[string]string
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		values, err = parseJSONConfig(data)
+	case ".toml":
+		values, err = parseTOMLConfig(data)
+	default:
+		values, err = parseDotEnv(data)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w (%s): %w", ErrInvalidConfig, path, err)
+	}
+	return configFromValues(values, path)
+}
+
+// LoadConfigFromEnv는 환경 변수에서 Config를 만듭니다.
+// 현재 디렉터리에 .env 파일이 있으면 먼저 읽고, 같은 이름의 환경 변수가 있으면 환경 변수 값을 우선합니다.
+// 인증키는 ASSEMBLY_API_KEY, APIKEY 환경 변수, .env 파일 순으로 우선합니다.
+func LoadConfigFromEnv() (*Config, error) {
+	values := make(map[string]string)
+	if data, err := os.ReadFile(".env"); err == nil {
+		values, err = parseDotEnv(data)
+		if err != nil {
+			return nil, fmt.Errorf("%w (.env): %w", ErrInvalidConfig, err)
+		}
+	}
+
+	// APIKEY는 .env보다 우선하고, 아래의 ASSEMBLY_API_KEY보다는 뒤로 밀리도록 먼저 적용합니다.
+	if v, ok := os.LookupEnv("APIKEY"); ok {
+		values[configAPIKey] = v
+	}
+	for _, key := range configKeys {
+		if v, ok := os.LookupEnv(envPrefix + strings.ToUpper(key)); ok {
+			values[key] = v
+		}
+	}
+	return configFromValues(values, "environment")
+}
+
+// NewClientFromEnv는 환경 변수(및 현재 디렉터리의 .env 파일)에서 설정을 읽어 Client를 생성합니다.
+// options는 설정에서 만든 옵션 뒤에 적용되므로 설정 값을 덮어쓸 수 있습니다.
+func NewClientFromEnv(options ...Option) (*Client, error) {
+	cfg, err := LoadConfigFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	return NewClientFromConfig(cfg, options...)
+}
+
+// NewClientFromConfig는 cfg로 Client를 생성합니다.
+// options는 설정에서 만든 옵션 뒤에 적용되므로 설정 값을 덮어쓸 수 있습니다.
+func NewClientFromConfig(cfg *Config, options ...Option) (*Client, error) {
+	configOptions, err := cfg.Options()
+	if err != nil {
+		return nil, err
+	}
+	return NewClient("", append(configOptions, options...)...)
+}
+
+// Options는 Config를 NewClient에 전달할 Option 목록으로 변환합니다.
+// APIKeysFile이나 CacheDir을 읽는 데 실패하면 에러를 반환합니다.
+func (cfg *Config) Options() ([]Option, error) {
+	keys := append([]string(nil), cfg.APIKeys...)
+	if cfg.APIKeysFile != "" {
+		fileKeys, err := LoadAPIKeysFile(cfg.APIKeysFile)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, fileKeys...)
+	}
+
+	var options []Option
+	if len(keys) > 0 {
+		options = append(options, WithAPIKeys(keys...))
+	}
+	if cfg.BaseURL != "" {
+		options = append(options, WithBaseURL(cfg.BaseURL))
+	}
+	if cfg.FileBaseURL != "" {
+		options = append(options, WithFileBaseURL(cfg.FileBaseURL))
+	}
+	if cfg.Timeout > 0 {
+		options = append(options, WithTimeout(cfg.Timeout))
+	}
+	if cfg.ConnectTimeout > 0 {
+		options = append(options, WithConnectTimeout(cfg.ConnectTimeout))
+	}
+	if cfg.DownloadTimeout > 0 {
+		options = append(options, WithDownloadTimeout(cfg.DownloadTimeout))
+	}
+	if cfg.ProxyURL != "" {
+		options = append(options, WithProxyURL(cfg.ProxyURL))
+	}
+	if cfg.Retries > 0 {
+		options = append(options, WithRetries(cfg.Retries))
+	}
+	if cfg.RateLimit > 0 {
+		options = append(options, WithRateLimit(cfg.RateLimit))
+	}
+	if cfg.UserAgent != "" {
+		options = append(options, WithUserAgent(cfg.UserAgent))
+	}
+	if cfg.CacheDir != "" {
+		store, err := NewFileValidatorStore(filepath.Join(cfg.CacheDir, "validators.json"))
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, WithValidatorStore(store))
+	}
+	return options, nil
+}
+
+// configFromValues는 항목 이름별 문자열 값을 검증해 Config로 변환합니다.
+func configFromValues(values map[string]string, source string) (*Config, error) {
+	cfg := &Config{
+		APIKeysFile: values[configAPIKeysFile],
+		CacheDir:    values[configCacheDir],
+		UserAgent:   values[configUserAgent],
+	}
+	cfgErr := &ConfigError{Source: source}
+	invalid := func(key, format string, args ...any) {
+		cfgErr.Invalid = append(cfgErr.Invalid, key+": "+fmt.Sprintf(format, args...))
+	}
+
+	for key := range values {
+		if !slices.Contains(configKeys, key) {
+			invalid(key, "unknown setting")
+		}
+	}
+
+	if v := values[configAPIKey]; v != "" {
+		cfg.APIKeys = append(cfg.APIKeys, v)
+	}
+	for _, key := range strings.Split(values[configAPIKeys], ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			cfg.APIKeys = append(cfg.APIKeys, key)
+		}
+	}
+	if len(cfg.APIKeys) == 0 && cfg.APIKeysFile == "" {
+		cfgErr.Missing = append(cfgErr.Missing, configAPIKey+" (or "+configAPIKeys+", "+configAPIKeysFile+")")
+	}
+
+	for key, dst := range map[string]*string{
+		configBaseURL:     &cfg.BaseURL,
+		configFileBaseURL: &cfg.FileBaseURL,
+		configProxyURL:    &cfg.ProxyURL,
+	} {
+		v := values[key]
+		if v == "" {
+			continue
+		}
+		if u, err := url.Parse(v); err != nil || u.Scheme == "" || u.Host == "" {
+			invalid(key, "%q is not an absolute URL", v)
+			continue
+		}
+		*dst = strings.TrimRight(v, "/")
+	}
+
+	for key, dst := range map[string]*time.Duration{
+		configTimeout:         &cfg.Timeout,
+		configConnectTimeout:  &cfg.ConnectTimeout,
+		configDownloadTimeout: &cfg.DownloadTimeout,
+	} {
+		v := values[key]
+		if v == "" {
+			continue
+		}
+		timeout, err := parseConfigDuration(v)
+		if err != nil || timeout <= 0 {
+			invalid(key, "%q is not a positive duration (e.g. 30s or 30)", v)
+		}
+		*dst = timeout
+	}
+	if v := values[configRetries]; v != "" {
+		retries, err := strconv.Atoi(v)
+		if err != nil || retries < 1 {
+			invalid(configRetries, "%q is not a positive integer", v)
+		}
+		cfg.Retries = retries
+	}
+	if v := values[configRateLimit]; v != "" {
+		rate, err := strconv.ParseFloat(v, 64)
+		if err != nil || rate < 0 {
+			invalid(configRateLimit, "%q is not a non-negative number of requests per second", v)
+		}
+		cfg.RateLimit = rate
+	}
+
+	if len(cfgErr.Missing) > 0 || len(cfgErr.Invalid) > 0 {
+		sort.Strings(cfgErr.Invalid) // 맵 순회 순서와 무관하게 항목 이름순으로 보고합니다.
+		return nil, cfgErr
+	}
+	return cfg, nil
+}
+
+// parseConfigDuration은 "30s" 같은 Go duration 또는 초 단위 정수를 해석합니다.
+func parseConfigDuration(v string) (time.Duration, error) {
+	if seconds, err := strconv.Atoi(v); err == nil {
+		return time.Duration(seconds) * time.Second, nil
+	}
+	return time.ParseDuration(v)
+}
+
+// parseDotEnv는 .env 형식(KEY=value)을 읽어 항목 이름별 값으로 변환합니다.
+// "ASSEMBLY_" 접두사가 붙은 변수와 APIKEY만 인식하며, 그 밖의 변수는 무시합니다.
+// 접두사가 붙었지만 알 수 없는 항목은 configFromValues가 잘못된 항목으로 보고합니다.
+func parseDotEnv(data []byte) (map[string]string, error) {
+	values := make(map[string]string)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+		name, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected KEY=value", lineNo)
+		}
+		name = strings.TrimSpace(name)
+		value = unquoteConfigValue(strings.TrimSpace(value))
+
+		switch {
+		case name == "APIKEY":
+			values[configAPIKey] = value
+		case strings.HasPrefix(name, envPrefix):
+			values[strings.ToLower(strings.TrimPrefix(name, envPrefix))] = value
+		}
+	}
+	return values, scanner.Err()
+}
+
+// parseTOMLConfig는 TOML의 단순한 부분집합(key = "문자열" | 숫자 | ["배열"])을 해석합니다.
+// [section] 헤더와 주석은 무시합니다.
+func parseTOMLConfig(data []byte) (map[string]string, error) {
+	values := make(map[string]string)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "[") {
+			continue
+		}
+		name, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected key = value", lineNo)
+		}
+		value = strings.TrimSpace(value)
+
+		if strings.HasPrefix(value, "[") {
+			if !strings.HasSuffix(value, "]") {
+				return nil, fmt.Errorf("line %d: arrays must be on a single line", lineNo)
+			}
+			var items []string
+			for _, item := range strings.Split(strings.Trim(value, "[]"), ",") {
+				if item = unquoteConfigValue(strings.TrimSpace(item)); item != "" {
+					items = append(items, item)
+				}
+			}
+			value = strings.Join(items, ",")
+		} else {
+			value = unquoteConfigValue(value)
+		}
+		values[strings.ToLower(strings.TrimSpace(name))] = value
+	}
+	return values, scanner.Err()
+}
+
+// parseJSONConfig는 JSON 객체를 항목 이름별 문자열 값으로 변환합니다.
+// 배열은 쉼표로 이어 붙이고, 숫자는 문자열로 바꿉니다.
+func parseJSONConfig(data []byte) (map[string]string, error) {
+	var raw map[string]any
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]string)
+	names := make([]string, 0, len(raw))
+	for name := range raw {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		key := strings.ToLower(name)
+		switch v := raw[name].(type) {
+		case string:
+			values[key] = v
+		case float64:
+			values[key] = strconv.FormatFloat(v, 'f', -1, 64)
+		case []any:
+			items := make([]string, 0, len(v))
+			for _, item := range v {
+				s, ok := item.(string)
+				if !ok {
+					return nil, fmt.Errorf("%s: array items must be strings", name)
+				}
+				items = append(items, s)
+			}
+			values[key] = strings.Join(items, ",")
+		case nil:
+		default:
+			return nil, fmt.Errorf("%s: unsupported value type %T", name, v)
+		}
+	}
+	return values, nil
+}
+
+// unquoteConfigValue는 값 앞뒤의 큰따옴표나 작은따옴표를 제거합니다.
+func unquoteConfigValue(v string) string {
+	if len(v) >= 2 && (v[0] == '"' || v[0] == '\'') && v[len(v)-1] == v[0] {
+		if v[0] == '"' {
+			if s, err := strconv.Unquote(v); err == nil {
+				return s
+			}
+		}
+		return v[1 : len(v)-1]
+	}
+	return v
+}
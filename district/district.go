@@ -0,0 +1,233 @@
+// Package district는 선거구명(ELECD_NM, ORIG_NM)을 시도, 시군구, 분구 번호로 나누고 행정표준코드에 맞춥니다.
+//
+// 선거구명은 "서울 종로구", "경기 수원시갑", "강원 춘천시철원군화천군양구군갑", "세종특별자치시을", "비례대표"처럼
+// 시도, 하나 이상의 시군구, 분구 표시(갑, 을, 병...)가 붙어 있습니다. 시군구 코드는 번들된 표(regions.go)에서 찾으므로
+// 네트워크 없이 지도 데이터와 합칠 수 있습니다.
+//
+//	d, err := district.Parse("경기 수원시갑")
+//	fmt.Println(d.Province, d.Municipality, d.Number, d.RegionCodes) // 경기 수원시 1 [41110]
+package district
+
+import (
+	"assembly_go/models"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// ErrUnknownDistrict는 선거구명에서 시도를 찾을 수 없을 때 발생합니다.
+var ErrUnknownDistrict = errors.New("unknown electoral district")
+
+// suffixes는 분구 표시입니다. Number는 이 순서의 1부터 시작하는 번호입니다.
+var suffixes = []rune("갑을병정무기")
+
+// District는 선거구 하나입니다.
+type District struct {
+	Raw            string   `json:"raw"`                       // 원래 선거구명
+	Province       string   `json:"province,omitempty"`        // 시도 약칭 (예: 경기)
+	Municipality   string   `json:"municipality,omitempty"`    // 분구 표시를 뗀 시군구 부분 (예: 춘천시철원군화천군양구군)
+	Municipalities []string `json:"municipalities,omitempty"`  // Municipality를 번들된 시군구 이름으로 나눈 것
+	Number         int      `json:"number,omitempty"`          // 분구 번호 (갑=1, 을=2, ...). 나뉘지 않은 선거구는 0
+	IsProportional bool     `json:"is_proportional,omitempty"` // 비례대표 (전국구)
+	ProvinceCode   string   `json:"province_code,omitempty"`   // 시도 행정표준코드 (2자리)
+	RegionCodes    []string `json:"region_codes,omitempty"`    // Municipalities의 시군구 행정표준코드 (5자리)
+}
+
+// Suffix는 분구 표시(갑, 을...)입니다. 나뉘지 않은 선거구는 빈 문자열입니다.
+func (d District) Suffix() string {
+	if d.Number < 1 || d.Number > len(suffixes) {
+		return ""
+	}
+	return string(suffixes[d.Number-1])
+}
+
+// Name은 "경기 수원시갑" 형식의 정규화된 선거구명입니다.
+func (d District) Name() string {
+	if d.IsProportional {
+		return "비례대표"
+	}
+	if d.Province == "" {
+		return d.Municipality + d.Suffix()
+	}
+	if d.Municipality == "" {
+		return d.Province + d.Suffix()
+	}
+	return d.Province + " " + d.Municipality + d.Suffix()
+}
+
+func (d District) String() string {
+	return d.Name()
+}
+
+// Parse는 선거구명을 나눕니다. 비례대표와 전국구는 IsProportional만 채웁니다.
+// 시도를 찾지 못하면 Municipality와 Number까지 채운 District와 함께 ErrUnknownDistrict를 반환합니다.
+// 번들된 표에 없는 시군구는 Municipalities와 RegionCodes에서 빠집니다.
+func Parse(name string) (District, error) {
+	d := District{Raw: name}
+	s := strings.Join(strings.Fields(name), " ")
+	if s == "" {
+		return d, fmt.Errorf("%w: empty name", ErrUnknownDistrict)
+	}
+	if strings.Contains(s, "비례대표") || strings.Contains(s, "전국구") {
+		d.IsProportional = true
+		return d, nil
+	}
+
+	rest, p := splitProvince(s)
+	rest = strings.ReplaceAll(rest, " ", "")
+	rest, d.Number = splitSuffix(rest)
+	d.Municipality = rest
+	if p == nil {
+		return d, fmt.Errorf("%w: %q", ErrUnknownDistrict, name)
+	}
+	d.Province, d.ProvinceCode = p.short, p.code
+	if rest == "" {
+		// "세종특별자치시갑"처럼 시도 전체가 선거구인 경우
+		if len(p.municipalities) == 1 {
+			d.Municipalities = []string{p.municipalities[0].Name}
+			d.RegionCodes = []string{p.municipalities[0].Code}
+		}
+		return d, nil
+	}
+	for _, m := range matchMunicipalities(p, rest) {
+		d.Municipalities = append(d.Municipalities, m.Name)
+		d.RegionCodes = append(d.RegionCodes, m.Code)
+	}
+	return d, nil
+}
+
+// splitProvince는 앞의 시도 이름(약칭, 정식 이름, 옛 이름)을 떼어냅니다. 띄어 쓰지 않은 경우("서울종로구")도 찾습니다.
+func splitProvince(s string) (string, *province) {
+	if head, tail, ok := strings.Cut(s, " "); ok {
+		if p := findProvince(head); p != nil {
+			return tail, p
+		}
+	}
+	var (
+		best    *province
+		bestLen int
+	)
+	for i := range provinces {
+		p := &provinces[i]
+		for _, n := range append([]string{p.short, p.name}, p.aliases...) {
+			if strings.HasPrefix(s, n) && len(n) > bestLen {
+				best, bestLen = p, len(n)
+			}
+		}
+	}
+	if best == nil {
+		return s, nil
+	}
+	return s[bestLen:], best
+}
+
+// splitSuffix는 끝의 분구 표시를 떼어냅니다. 분구 표시는 시, 군, 구 뒤나 시도만 있는 경우에만 인정합니다.
+func splitSuffix(s string) (string, int) {
+	last, size := utf8.DecodeLastRuneInString(s)
+	for i, r := range suffixes {
+		if r != last {
+			continue
+		}
+		rest := s[:len(s)-size]
+		prev, _ := utf8.DecodeLastRuneInString(rest)
+		if rest == "" || prev == '시' || prev == '군' || prev == '구' {
+			return rest, i + 1
+		}
+	}
+	return s, 0
+}
+
+// matchMunicipalities는 s를 앞에서부터 p의 시군구 이름 중 가장 긴 것과 맞춰 나눕니다.
+// 맞지 않는 글자(예: "성남시분당구"의 "분당구" 같은 일반구)는 건너뜁니다.
+func matchMunicipalities(p *province, s string) []Region {
+	var out []Region
+	for s != "" {
+		var (
+			best    Region
+			bestLen int
+		)
+		for _, m := range p.municipalities {
+			if strings.HasPrefix(s, m.Name) && len(m.Name) > bestLen {
+				best, bestLen = m, len(m.Name)
+			}
+		}
+		if bestLen == 0 {
+			_, size := utf8.DecodeRuneInString(s)
+			s = s[size:]
+			continue
+		}
+		if !containsRegion(out, best.Code) {
+			out = append(out, best)
+		}
+		s = s[bestLen:]
+	}
+	return out
+}
+
+func containsRegion(rs []Region, code string) bool {
+	for _, r := range rs {
+		if r.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// FromAllNameMember는 국회의원 정보 통합 행의 선거구명(ELECD_NM)을 나눕니다.
+// 선거구 구분(ELECD_DIV_NM)이 비례대표면 선거구명과 관계없이 비례대표입니다.
+func FromAllNameMember(row models.AllNameMemberRow) (District, error) {
+	if strings.Contains(row.ElecdDivNm, "비례대표") {
+		return District{Raw: row.ElecdNm, IsProportional: true}, nil
+	}
+	return Parse(row.ElecdNm)
+}
+
+// Index는 표결 행 등에서 본 선거구 코드(ORIG_CD)와 선거구명(ORIG_NM)을 모아 코드로 선거구를 찾게 합니다.
+// ORIG_CD는 번들된 표에 없으므로 행을 읽으며 익힙니다. 여러 고루틴에서 함께 써도 안전합니다.
+type Index struct {
+	mu     sync.RWMutex
+	byCode map[string]District
+}
+
+// NewIndex는 빈 Index를 만듭니다.
+func NewIndex() *Index {
+	return &Index{byCode: make(map[string]District)}
+}
+
+// Observe는 선거구 코드와 이름을 익히고 나눈 선거구를 반환합니다. 코드가 비어있으면 나누기만 합니다.
+// 같은 코드를 다시 보면 마지막 이름으로 바꿉니다.
+func (x *Index) Observe(code, name string) (District, error) {
+	d, err := Parse(name)
+	code = strings.TrimSpace(code)
+	if code == "" || strings.TrimSpace(name) == "" {
+		return d, err
+	}
+	x.mu.Lock()
+	x.byCode[code] = d
+	x.mu.Unlock()
+	return d, err
+}
+
+// ObserveVotes는 표결 행의 ORIG_CD와 ORIG_NM을 익힙니다.
+func (x *Index) ObserveVotes(rows ...models.NojepdqqaweusdfbiRow) {
+	for _, row := range rows {
+		x.Observe(row.ConstituencyCode, row.Constituency)
+	}
+}
+
+// Lookup은 ORIG_CD로 선거구를 찾습니다.
+func (x *Index) Lookup(code string) (District, bool) {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	d, ok := x.byCode[strings.TrimSpace(code)]
+	return d, ok
+}
+
+// Len은 익힌 선거구 코드 수입니다.
+func (x *Index) Len() int {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	return len(x.byCode)
+}
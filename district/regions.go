@@ -0,0 +1,144 @@
+package district
+
+// Region은 행정구역 하나와 행정표준코드입니다. 시도는 2자리, 시군구는 5자리입니다.
+type Region struct {
+	Code     string `json:"code"`
+	Province string `json:"province"` // 시도 약칭 (예: 서울)
+	Name     string `json:"name"`     // 시도는 정식 이름, 시군구는 시군구 이름 (예: 종로구)
+}
+
+// province는 시도 하나입니다. aliases는 선거구명에 나오는 다른 표기입니다.
+type province struct {
+	short, name, code string
+	aliases           []string
+	municipalities    []Region
+}
+
+func regions(province string, pairs ...string) []Region {
+	out := make([]Region, 0, len(pairs)/2)
+	for i := 0; i+1 < len(pairs); i += 2 {
+		out = append(out, Region{Code: pairs[i], Province: province, Name: pairs[i+1]})
+	}
+	return out
+}
+
+// provinces는 2024년 기준 시도와 시군구 코드입니다. 자치구가 없는 시의 일반구(예: 수원시 장안구)는 시 단위로만 담았습니다.
+// 강원과 전북은 특별자치도 출범 후 코드(51, 52)이며, 옛 이름(강원도, 전라북도)도 같은 코드로 찾습니다.
+var provinces = []province{
+	{"서울", "서울특별시", "11", []string{"서울시"}, regions("서울",
+		"11110", "종로구", "11140", "중구", "11170", "용산구", "11200", "성동구", "11215", "광진구",
+		"11230", "동대문구", "11260", "중랑구", "11290", "성북구", "11305", "강북구", "11320", "도봉구",
+		"11350", "노원구", "11380", "은평구", "11410", "서대문구", "11440", "마포구", "11470", "양천구",
+		"11500", "강서구", "11530", "구로구", "11545", "금천구", "11560", "영등포구", "11590", "동작구",
+		"11620", "관악구", "11650", "서초구", "11680", "강남구", "11710", "송파구", "11740", "강동구")},
+	{"부산", "부산광역시", "26", []string{"부산시"}, regions("부산",
+		"26110", "중구", "26140", "서구", "26170", "동구", "26200", "영도구", "26230", "부산진구",
+		"26260", "동래구", "26290", "남구", "26320", "북구", "26350", "해운대구", "26380", "사하구",
+		"26410", "금정구", "26440", "강서구", "26470", "연제구", "26500", "수영구", "26530", "사상구",
+		"26710", "기장군")},
+	{"대구", "대구광역시", "27", []string{"대구시"}, regions("대구",
+		"27110", "중구", "27140", "동구", "27170", "서구", "27200", "남구", "27230", "북구",
+		"27260", "수성구", "27290", "달서구", "27710", "달성군", "27720", "군위군")},
+	{"인천", "인천광역시", "28", []string{"인천시"}, regions("인천",
+		"28110", "중구", "28140", "동구", "28177", "미추홀구", "28185", "연수구", "28200", "남동구",
+		"28237", "부평구", "28245", "계양구", "28260", "서구", "28710", "강화군", "28720", "옹진군")},
+	{"광주", "광주광역시", "29", nil, regions("광주",
+		"29110", "동구", "29140", "서구", "29155", "남구", "29170", "북구", "29200", "광산구")},
+	{"대전", "대전광역시", "30", []string{"대전시"}, regions("대전",
+		"30110", "동구", "30140", "중구", "30170", "서구", "30200", "유성구", "30230", "대덕구")},
+	{"울산", "울산광역시", "31", []string{"울산시"}, regions("울산",
+		"31110", "중구", "31140", "남구", "31170", "동구", "31200", "북구", "31710", "울주군")},
+	{"세종", "세종특별자치시", "36", []string{"세종시"}, regions("세종", "36110", "세종시")},
+	{"경기", "경기도", "41", nil, regions("경기",
+		"41110", "수원시", "41130", "성남시", "41150", "의정부시", "41170", "안양시", "41190", "부천시",
+		"41210", "광명시", "41220", "평택시", "41250", "동두천시", "41270", "안산시", "41280", "고양시",
+		"41290", "과천시", "41310", "구리시", "41360", "남양주시", "41370", "오산시", "41390", "시흥시",
+		"41410", "군포시", "41430", "의왕시", "41450", "하남시", "41460", "용인시", "41480", "파주시",
+		"41500", "이천시", "41550", "안성시", "41570", "김포시", "41590", "화성시", "41610", "광주시",
+		"41630", "양주시", "41650", "포천시", "41670", "여주시", "41800", "연천군", "41820", "가평군",
+		"41830", "양평군")},
+	{"강원", "강원특별자치도", "51", []string{"강원도"}, regions("강원",
+		"51110", "춘천시", "51130", "원주시", "51150", "강릉시", "51170", "동해시", "51190", "태백시",
+		"51210", "속초시", "51230", "삼척시", "51720", "홍천군", "51730", "횡성군", "51750", "영월군",
+		"51760", "평창군", "51770", "정선군", "51780", "철원군", "51790", "화천군", "51800", "양구군",
+		"51810", "인제군", "51820", "고성군", "51830", "양양군")},
+	{"충북", "충청북도", "43", nil, regions("충북",
+		"43110", "청주시", "43130", "충주시", "43150", "제천시", "43720", "보은군", "43730", "옥천군",
+		"43740", "영동군", "43745", "증평군", "43750", "진천군", "43760", "괴산군", "43770", "음성군",
+		"43800", "단양군")},
+	{"충남", "충청남도", "44", nil, regions("충남",
+		"44130", "천안시", "44150", "공주시", "44180", "보령시", "44200", "아산시", "44210", "서산시",
+		"44230", "논산시", "44250", "계룡시", "44270", "당진시", "44710", "금산군", "44760", "부여군",
+		"44770", "서천군", "44790", "청양군", "44800", "홍성군", "44810", "예산군", "44825", "태안군")},
+	{"전북", "전북특별자치도", "52", []string{"전라북도"}, regions("전북",
+		"52110", "전주시", "52130", "군산시", "52140", "익산시", "52180", "정읍시", "52190", "남원시",
+		"52210", "김제시", "52710", "완주군", "52720", "진안군", "52730", "무주군", "52740", "장수군",
+		"52750", "임실군", "52770", "순창군", "52790", "고창군", "52800", "부안군")},
+	{"전남", "전라남도", "46", nil, regions("전남",
+		"46110", "목포시", "46130", "여수시", "46150", "순천시", "46170", "나주시", "46230", "광양시",
+		"46710", "담양군", "46720", "곡성군", "46730", "구례군", "46770", "고흥군", "46780", "보성군",
+		"46790", "화순군", "46800", "장흥군", "46810", "강진군", "46820", "해남군", "46830", "영암군",
+		"46840", "무안군", "46860", "함평군", "46870", "영광군", "46880", "장성군", "46890", "완도군",
+		"46900", "진도군", "46910", "신안군")},
+	{"경북", "경상북도", "47", nil, regions("경북",
+		"47110", "포항시", "47130", "경주시", "47150", "김천시", "47170", "안동시", "47190", "구미시",
+		"47210", "영주시", "47230", "영천시", "47250", "상주시", "47280", "문경시", "47290", "경산시",
+		"47730", "의성군", "47750", "청송군", "47760", "영양군", "47770", "영덕군", "47820", "청도군",
+		"47830", "고령군", "47840", "성주군", "47850", "칠곡군", "47900", "예천군", "47920", "봉화군",
+		"47930", "울진군", "47940", "울릉군")},
+	{"경남", "경상남도", "48", nil, regions("경남",
+		"48120", "창원시", "48170", "진주시", "48220", "통영시", "48240", "사천시", "48250", "김해시",
+		"48270", "밀양시", "48310", "거제시", "48330", "양산시", "48720", "의령군", "48730", "함안군",
+		"48740", "창녕군", "48820", "고성군", "48840", "남해군", "48850", "하동군", "48860", "산청군",
+		"48870", "함양군", "48880", "거창군", "48890", "합천군")},
+	{"제주", "제주특별자치도", "50", []string{"제주도"}, regions("제주",
+		"50110", "제주시", "50130", "서귀포시")},
+}
+
+// Provinces는 번들된 시도 목록을 반환합니다.
+func Provinces() []Region {
+	out := make([]Region, len(provinces))
+	for i, p := range provinces {
+		out[i] = Region{Code: p.code, Province: p.short, Name: p.name}
+	}
+	return out
+}
+
+// Municipalities는 시도(약칭이나 정식 이름)의 시군구 목록을 반환합니다. 모르는 시도면 nil입니다.
+func Municipalities(provinceName string) []Region {
+	p := findProvince(provinceName)
+	if p == nil {
+		return nil
+	}
+	return append([]Region(nil), p.municipalities...)
+}
+
+// RegionByCode는 행정표준코드(2자리 시도 또는 5자리 시군구)로 행정구역을 찾습니다.
+func RegionByCode(code string) (Region, bool) {
+	for _, p := range provinces {
+		if p.code == code {
+			return Region{Code: p.code, Province: p.short, Name: p.name}, true
+		}
+		for _, m := range p.municipalities {
+			if m.Code == code {
+				return m, true
+			}
+		}
+	}
+	return Region{}, false
+}
+
+func findProvince(name string) *province {
+	for i := range provinces {
+		p := &provinces[i]
+		if name == p.short || name == p.name {
+			return p
+		}
+		for _, alias := range p.aliases {
+			if name == alias {
+				return p
+			}
+		}
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ package assembly_go
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 )
@@ -11,8 +12,38 @@ import (
 // downloadBillPdf는 innerBillId를 사용하여 법안 PDF 원문을 다운로드합니다.
 // 이는 기존 leginote-worker-bill/downloader/billpdf.go의 로직을 가져옵니다.
 func (c *Client) downloadBillPdf(innerBillId string) ([]byte, error) {
+	req, err := c.newBillPdfRequest(innerBillId)
+	if err != nil {
+		return nil, err
+	}
+
+	pdfBinary, err := c.download(req) // Client의 공통 download 헬퍼 함수 사용
+	if err != nil {
+		return nil, err
+	}
+
+	// isHTMLContent는 SDK 내부에서만 사용되므로 여기에 정의하거나, 별도 util 파일로 분리 가능
+	// leginote-worker-bill/util/validation.go 로직 사용
+	if IsHTMLContent(pdfBinary) {
+		return nil, fmt.Errorf("%w (innerBillId: %s)", ErrHTMLContent, innerBillId)
+	}
+
+	return pdfBinary, nil
+}
+
+// newBillPdfRequest는 법안 PDF를 내려받는 filegate 요청을 생성합니다.
+func (c *Client) newBillPdfRequest(innerBillId string) (*http.Request, error) {
+	fullURL, err := c.BillPDFURL(innerBillId)
+	if err != nil {
+		return nil, err
+	}
+	return c.newRequest(http.MethodGet, fullURL, requestFileGateway)
+}
+
+// BillPDFURL은 innerBillId의 법안 PDF 원문을 내려받는 filegate 주소를 반환합니다.
+func (c *Client) BillPDFURL(innerBillId string) (string, error) {
 	if innerBillId == "" {
-		return nil, ErrInvalidID
+		return "", ErrInvalidID
 	}
 
 	// URL 정의 및 파라미터 설정
@@ -20,43 +51,119 @@ func (c *Client) downloadBillPdf(innerBillId string) ([]byte, error) {
 	params.Add("dummy", "dummy")
 	params.Add("bookId", innerBillId)
 	params.Add("type", "1")
-	fullURL := fmt.Sprintf("%s/filegate/sender30?%s", c.baseURL, params.Encode())
+	fileBaseURL := c.fileBaseURL
+	if fileBaseURL == "" {
+		fileBaseURL = c.baseURL
+	}
+	return fmt.Sprintf("%s/filegate/sender30?%s", fileBaseURL, params.Encode()), nil
+}
 
-	req, err := http.NewRequest("GET", fullURL, nil)
+// downloadBillPdfIfModified는 저장된 검증자로 조건부 요청을 보내 법안 PDF를 다운로드합니다.
+// 검증자는 innerBillId 기준으로 저장되며, HTML 오류 페이지를 받은 경우에는 갱신하지 않습니다.
+func (c *Client) downloadBillPdfIfModified(innerBillId string) (*DownloadResult, error) {
+	req, err := c.newBillPdfRequest(innerBillId)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrRequestFailed, err)
+		return nil, err
 	}
 
-	req.Header.Set("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/58.0.3029.110 Safari/537.36")
-
-	pdfBinary, err := c.download(req) // Client의 공통 download 헬퍼 함수 사용
+	key := "bill:" + innerBillId
+	result, err := c.downloadIfModified(req, key)
 	if err != nil {
 		return nil, err
 	}
+	if result.NotModified {
+		return result, nil
+	}
 
-	// isHTMLContent는 SDK 내부에서만 사용되므로 여기에 정의하거나, 별도 util 파일로 분리 가능
-	// leginote-worker-bill/util/validation.go 로직 사용
-	if IsHTMLContent(pdfBinary) {
+	if IsHTMLContent(result.Data) {
 		return nil, fmt.Errorf("%w (innerBillId: %s)", ErrHTMLContent, innerBillId)
 	}
-
-	return pdfBinary, nil
+	if err := c.validators.Set(key, result.Validators); err != nil {
+		return nil, err
+	}
+	return result, nil
 }
 
 // downloadMeetingRecordPdf는 API가 제공하는 최종 회의록 URL을 받아 PDF를 다운로드합니다.
 // 기존 leginote-assembly-go/meeting_record.go 의 로직을 가져옵니다.
 func (c *Client) downloadPdfWithUrl(pdfURL string) ([]byte, error) {
+	req, err := c.newMeetingRecordRequest(pdfURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.download(req) // Client의 공통 download 헬퍼 함수 사용
+}
+
+// newMeetingRecordRequest는 회의록 PDF URL에 대한 다운로드 요청을 생성합니다.
+func (c *Client) newMeetingRecordRequest(pdfURL string) (*http.Request, error) {
 	if pdfURL == "" {
 		return nil, ErrInvalidID
 	}
+	return c.newRequest(http.MethodGet, pdfURL, requestFileGateway)
+}
 
-	req, err := http.NewRequest("GET", pdfURL, nil)
+// downloadPdfWithUrlIfModified는 저장된 검증자로 조건부 요청을 보내 회의록 PDF를 다운로드합니다.
+// 검증자는 pdfURL 기준으로 저장됩니다.
+func (c *Client) downloadPdfWithUrlIfModified(pdfURL string) (*DownloadResult, error) {
+	req, err := c.newMeetingRecordRequest(pdfURL)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %v", ErrRequestFailed, err)
+		return nil, err
 	}
-	req.Header.Set("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/58.0.3029.110 Safari/537.36")
 
-	return c.download(req) // Client의 공통 download 헬퍼 함수 사용
+	result, err := c.downloadIfModified(req, pdfURL)
+	if err != nil {
+		return nil, err
+	}
+	if !result.NotModified {
+		if err := c.validators.Set(pdfURL, result.Validators); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// DownloadResult는 조건부 다운로드의 결과입니다.
+type DownloadResult struct {
+	Data        []byte     // 다운로드한 내용 (NotModified인 경우 nil)
+	NotModified bool       // 서버가 304 Not Modified를 반환해 이전 내용이 그대로 유효한지 여부
+	Validators  Validators // 응답에서 받은 검증자 (NotModified인 경우 기존에 저장된 값)
+}
+
+// downloadIfModified는 key에 저장된 검증자로 If-None-Match / If-Modified-Since 헤더를 설정해 요청합니다.
+// 검증자 저장은 내용 검증이 끝난 뒤 호출자가 수행합니다.
+func (c *Client) downloadIfModified(req *http.Request, key string) (*DownloadResult, error) {
+	prev, ok := c.validators.Get(key)
+	if ok {
+		if prev.ETag != "" {
+			req.Header.Set("If-None-Match", prev.ETag)
+		}
+		if prev.LastModified != "" {
+			req.Header.Set("If-Modified-Since", prev.LastModified)
+		}
+	}
+
+	resp, err := c.doWithRetry(req, http.StatusOK, http.StatusNotModified)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified {
+		return &DownloadResult{NotModified: true, Validators: prev}, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrDownloadFailed, err)
+	}
+	return &DownloadResult{
+		Data: body,
+		Validators: Validators{
+			ETag:         resp.Header.Get("ETag"),
+			LastModified: resp.Header.Get("Last-Modified"),
+		},
+	}, nil
 }
 
 // isHTMLContent는 다운로드된 데이터가 HTML 문서인지 확인합니다. (이 함수는 이 파일 내에서만 사용될 수 있습니다.)
@@ -15,4 +15,16 @@ var (
 
 	// ErrHTMLContent는 다운로드한 내용이 기대했던 파일이 아닌 HTML 문서일 때 발생합니다.
 	ErrHTMLContent = errors.New("downloaded content is an HTML document, not the expected file")
+
+	// ErrNoAPIKey는 인증키가 없거나, 등록된 모든 인증키가 호출 한도를 초과했거나 유효하지 않을 때 발생합니다.
+	ErrNoAPIKey = errors.New("no usable API key")
+
+	// ErrInvalidConfig는 환경 변수나 설정 파일에 빠졌거나 잘못된 항목이 있을 때 발생합니다.
+	ErrInvalidConfig = errors.New("invalid client configuration")
+
+	// ErrNotFound는 요청한 법안 등을 찾을 수 없을 때 발생합니다.
+	ErrNotFound = errors.New("requested item was not found")
+
+	// ErrPartialResult는 여러 조회를 모아 만드는 결과에서 일부 조회만 실패했을 때 발생합니다. 함께 반환된 결과는 사용할 수 있습니다.
+	ErrPartialResult = errors.New("some parts of the result could not be fetched")
 )
@@ -0,0 +1,19 @@
+package assembly_go
+
+import "assembly_go/models"
+
+// Fetcher는 법안, 의원 인적사항, 표결, 회의록을 조회하는 메서드 모음입니다.
+// Client는 OpenAPI를 호출하고 localstore.Store는 미리 동기화해 둔 로컬 데이터를 조회하므로,
+// 이 인터페이스로 받으면 같은 코드를 온라인과 오프라인에서 모두 쓸 수 있습니다.
+type Fetcher interface {
+	FetchBills(params models.TVBPMBILL11RequestParams) (*models.TVBPMBILL11Response, error)
+	FetchBillsWithOptions(params models.TVBPMBILL11RequestParams, optional models.TVBPMBILL11OptionalParams) (*models.TVBPMBILL11Response, error)
+	FetchBillConferenceList(params models.VCONFBILLCONFLISTRequestParams) (*models.VCONFBILLCONFLISTResponse, error)
+	FetchMeetingConferenceList(params models.VCONFPHCONFLISTRequestParams) (*models.VCONFPHCONFLISTResponse, error)
+	FetchMemberVoteResult(params models.NojepdqqaweusdfbiRequestParams) (*models.NojepdqqaweusdfbiResponse, error)
+	FetchMemberVoteResultWithOptions(params models.NojepdqqaweusdfbiRequestParams, optional models.NojepdqqaweusdfbiOptionalParams) (*models.NojepdqqaweusdfbiResponse, error)
+	FetchMemberDetails(params models.NwvrqwxyaytdsfvhuRequestParams) (*models.NwvrqwxyaytdsfvhuResponse, error)
+	FetchMemberDetailsWithOptions(params models.NwvrqwxyaytdsfvhuRequestParams, optional models.NwvrqwxyaytdsfvhuOptionalParams) (*models.NwvrqwxyaytdsfvhuResponse, error)
+}
+
+var _ Fetcher = (*Client)(nil)
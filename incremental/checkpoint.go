@@ -0,0 +1,142 @@
+package incremental
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Checkpoint는 엔드포인트와 범위(Scope) 하나에 대한 동기화 진행 상태입니다.
+type Checkpoint struct {
+	Endpoint string              `json:"endpoint"`
+	Scope    string              `json:"scope"`
+	Cursors  map[string]string   `json:"cursors,omitempty"` // 날짜 필드(PROPOSE_DT, PROC_DT, VOTE_DATE, CONF_DT 등)별로 지금까지 본 가장 늦은 값
+	Page     int                 `json:"page,omitempty"`    // 진행 중인 패스에서 마지막으로 처리한 페이지. 0이면 진행 중인 패스가 없습니다.
+	Pass     int                 `json:"pass"`              // 지금까지 시작한 패스 수
+	Full     bool                `json:"full,omitempty"`    // 진행 중인 패스가 전체 조회인지 여부
+	Rows     map[string]RowState `json:"rows"`              // 행 Key별 상태
+	SyncedAt time.Time           `json:"synced_at"`         // 마지막으로 동기화를 마친 시각
+}
+
+// RowState는 체크포인트에 기록된 행 하나의 상태입니다. 행 전체가 아니라 해시만 저장합니다.
+type RowState struct {
+	Hash string `json:"hash"` // 행을 JSON으로 인코딩한 내용의 SHA-256 (앞 16바이트)
+	Pass int    `json:"pass"` // 이 행을 마지막으로 본 패스
+}
+
+func newCheckpoint(endpoint, scope string) *Checkpoint {
+	return &Checkpoint{Endpoint: endpoint, Scope: scope, Cursors: make(map[string]string), Rows: make(map[string]RowState)}
+}
+
+// CheckpointStore는 체크포인트를 보관하는 저장소입니다. id는 Source.ID()입니다.
+// 데이터베이스 등에 저장하려면 이 인터페이스를 구현합니다.
+type CheckpointStore interface {
+	// Load는 id의 체크포인트를 반환합니다. 저장된 것이 없으면 nil, nil을 반환합니다.
+	Load(ctx context.Context, id string) (*Checkpoint, error)
+	// Save는 id의 체크포인트를 저장합니다.
+	Save(ctx context.Context, id string, cp *Checkpoint) error
+}
+
+// MemoryStore는 메모리에 체크포인트를 보관하는 CheckpointStore입니다. 테스트나 한 프로세스 안에서만 쓸 때 사용합니다.
+type MemoryStore struct {
+	mu          sync.Mutex
+	checkpoints map[string][]byte
+}
+
+// NewMemoryStore는 비어있는 MemoryStore를 생성합니다.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{checkpoints: make(map[string][]byte)}
+}
+
+// Load는 저장된 체크포인트의 복사본을 반환합니다.
+func (s *MemoryStore) Load(_ context.Context, id string) (*Checkpoint, error) {
+	s.mu.Lock()
+	data, ok := s.checkpoints[id]
+	s.mu.Unlock()
+	if !ok {
+		return nil, nil
+	}
+	return decodeCheckpoint(data)
+}
+
+// Save는 체크포인트의 복사본을 저장합니다. 이후 cp를 바꿔도 저장된 값은 바뀌지 않습니다.
+func (s *MemoryStore) Save(_ context.Context, id string, cp *Checkpoint) error {
+	data, err := json.Marshal(cp)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.checkpoints[id] = data
+	return nil
+}
+
+// FileStore는 디렉터리에 체크포인트를 id별 JSON 파일로 보관하는 CheckpointStore입니다.
+type FileStore struct {
+	dir string
+}
+
+// NewFileStore는 dir에 체크포인트를 저장하는 FileStore를 생성합니다. 디렉터리는 첫 Save 때 만들어집니다.
+func NewFileStore(dir string) *FileStore {
+	return &FileStore{dir: dir}
+}
+
+// path는 id의 체크포인트 파일 경로입니다. "TVBPMBILL11/AGE=22"는 "TVBPMBILL11_AGE=22.json"이 됩니다.
+func (s *FileStore) path(id string) string {
+	name := strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`/\:*?"<>|`, r) || r < ' ' {
+			return '_'
+		}
+		return r
+	}, id)
+	return filepath.Join(s.dir, name+".json")
+}
+
+// Load는 id의 체크포인트 파일을 읽습니다. 파일이 없으면 nil, nil을 반환합니다.
+func (s *FileStore) Load(_ context.Context, id string) (*Checkpoint, error) {
+	data, err := os.ReadFile(s.path(id))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return decodeCheckpoint(data)
+}
+
+// Save는 체크포인트를 임시 파일에 쓴 뒤 이름을 바꿔 반영합니다. 저장 도중 중단되어도 이전 체크포인트는 그대로 남습니다.
+func (s *FileStore) Save(_ context.Context, id string, cp *Checkpoint) error {
+	data, err := json.MarshalIndent(cp, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(s.dir, 0o755); err != nil {
+		return err
+	}
+	path := s.path(id)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+func decodeCheckpoint(data []byte) (*Checkpoint, error) {
+	var cp Checkpoint
+	if err := json.Unmarshal(data, &cp); err != nil {
+		return nil, fmt.Errorf("failed to parse checkpoint: %w", err)
+	}
+	if cp.Cursors == nil {
+		cp.Cursors = make(map[string]string)
+	}
+	if cp.Rows == nil {
+		cp.Rows = make(map[string]RowState)
+	}
+	return &cp, nil
+}
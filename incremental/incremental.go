@@ -0,0 +1,407 @@
+// Package incremental은 국회 OpenAPI 데이터를 주기적으로 미러링할 때 새로 생기거나 바뀐 행만 가져오는 증분 동기화 엔진입니다.
+//
+// 엔드포인트와 범위(Source)마다 체크포인트(날짜 필드별 마지막 값, 마지막 페이지, 행별 해시)를 CheckpointStore에 저장합니다.
+// 다음 실행에서는 최신순 목록을 바뀐 행이 없는 페이지가 나올 때까지만 읽고, 날짜 조건을 지원하는 필드는
+// 마지막으로 본 날짜부터 오늘까지 하루씩 조회해 오래된 행의 변경도 찾아냅니다.
+// 찾은 변경은 Added, Updated, Removed 이벤트로 Sink에 전달합니다. Removed는 전체 조회(WithFullScan, WithFullScanEvery) 때만 나옵니다.
+//
+//	store := incremental.NewFileStore("checkpoints")
+//	s, _ := incremental.New(client, incremental.Bills("22"), store, incremental.SinkFunc[models.TVBPMBILL11Row](
+//		func(ctx context.Context, e incremental.Event[models.TVBPMBILL11Row]) error {
+//			log.Println(e.Kind, e.Key, e.Row.BillName)
+//			return nil
+//		}))
+//	stats, err := s.Sync(ctx)
+package incremental
+
+import (
+	"assembly_go"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"time"
+)
+
+// ChangeKind는 변경 이벤트의 종류입니다.
+type ChangeKind string
+
+const (
+	Added   ChangeKind = "added"   // 체크포인트에 없던 행
+	Updated ChangeKind = "updated" // 체크포인트의 해시와 내용이 다른 행
+	Removed ChangeKind = "removed" // 체크포인트에는 있지만 전체 조회에서 나오지 않은 행
+)
+
+// Event는 Sink에 전달되는 변경 하나입니다.
+type Event[T any] struct {
+	Kind     ChangeKind
+	Endpoint string
+	Scope    string
+	Key      string
+	Row      T // Removed 이벤트에서는 체크포인트에 행 내용이 없으므로 0값입니다.
+}
+
+// Sink는 변경 이벤트를 받는 곳입니다. Emit이 에러를 반환하면 동기화를 멈추고, 그 페이지는 체크포인트에 반영하지 않습니다.
+// 따라서 같은 이벤트가 다시 전달될 수 있으므로 Emit은 Key 기준으로 멱등하게 처리해야 합니다.
+type Sink[T any] interface {
+	Emit(ctx context.Context, event Event[T]) error
+}
+
+// SinkFunc는 함수를 Sink로 사용하게 합니다.
+type SinkFunc[T any] func(ctx context.Context, event Event[T]) error
+
+// Emit은 f(ctx, event)를 호출합니다.
+func (f SinkFunc[T]) Emit(ctx context.Context, event Event[T]) error {
+	return f(ctx, event)
+}
+
+// Stats는 Sync 한 번의 결과입니다.
+type Stats struct {
+	Pages     int  // 요청한 페이지 수
+	Rows      int  // 받은 행 수
+	Added     int  // Added 이벤트 수
+	Updated   int  // Updated 이벤트 수
+	Removed   int  // Removed 이벤트 수
+	Unchanged int  // 바뀌지 않은 행 수
+	Full      bool // 전체 조회였는지 여부
+}
+
+// dateLayout은 날짜 조건으로 보내는 값의 형식입니다.
+const dateLayout = "2006-01-02"
+
+// config는 Option으로 바꿀 수 있는 동기화 설정입니다.
+type config struct {
+	pageSize  int
+	stopAfter int
+	maxDays   int
+	fullEvery int
+	full      bool
+	now       func() time.Time
+}
+
+// Option은 Syncer 설정을 변경하는 함수 타입입니다.
+type Option func(*config)
+
+// WithPageSize는 한 번에 요청할 행 수를 설정합니다. 기본값은 100이며 포털의 최대값은 1000입니다.
+func WithPageSize(n int) Option {
+	return func(c *config) {
+		if n > 0 {
+			c.pageSize = n
+		}
+	}
+}
+
+// WithStopAfter는 증분 동기화에서 바뀐 행이 하나도 없는 페이지가 몇 번 연속으로 나오면 목록 읽기를 멈출지 설정합니다. 기본값은 1입니다.
+func WithStopAfter(pages int) Option {
+	return func(c *config) {
+		if pages > 0 {
+			c.stopAfter = pages
+		}
+	}
+}
+
+// WithMaxDays는 날짜 조건으로 하루씩 조회할 최대 일수를 설정합니다. 기본값은 31입니다.
+// 마지막 동기화가 이보다 오래되었으면 날짜별로 조회하는 대신 전체 조회를 합니다.
+func WithMaxDays(days int) Option {
+	return func(c *config) {
+		if days > 0 {
+			c.maxDays = days
+		}
+	}
+}
+
+// WithFullScan은 모든 페이지를 읽어 삭제된 행까지 찾는 전체 조회를 하게 합니다. 첫 동기화는 항상 전체 조회입니다.
+func WithFullScan() Option {
+	return func(c *config) {
+		c.full = true
+	}
+}
+
+// WithFullScanEvery는 passes번째 동기화마다 전체 조회를 하게 합니다. 기본값은 7입니다.
+// 전체 조회 때만 삭제된 행을 찾아 체크포인트에서 그 해시를 지우므로, 0 이하를 주면 체크포인트가 계속 커질 수 있습니다.
+func WithFullScanEvery(passes int) Option {
+	return func(c *config) {
+		c.fullEvery = passes
+	}
+}
+
+// WithClock은 날짜별 조회의 기준이 되는 현재 시각 함수를 설정합니다. 테스트에서 사용합니다.
+func WithClock(now func() time.Time) Option {
+	return func(c *config) {
+		if now != nil {
+			c.now = now
+		}
+	}
+}
+
+// Syncer는 Source 하나를 체크포인트에 맞춰 동기화합니다. 같은 Source를 여러 Syncer가 동시에 동기화하면 안 됩니다.
+type Syncer[T any] struct {
+	client *assembly_go.Client
+	source Source[T]
+	store  CheckpointStore
+	sink   Sink[T]
+	cfg    config
+}
+
+// New는 Syncer를 생성합니다.
+func New[T any](client *assembly_go.Client, source Source[T], store CheckpointStore, sink Sink[T], options ...Option) (*Syncer[T], error) {
+	switch {
+	case client == nil:
+		return nil, fmt.Errorf("%w: client is nil", assembly_go.ErrInvalidConfig)
+	case store == nil:
+		return nil, fmt.Errorf("%w: checkpoint store is nil", assembly_go.ErrInvalidConfig)
+	case sink == nil:
+		return nil, fmt.Errorf("%w: sink is nil", assembly_go.ErrInvalidConfig)
+	case source.Endpoint == "" || source.Key == nil || source.Fetch == nil:
+		return nil, fmt.Errorf("%w: source needs Endpoint, Key and Fetch", assembly_go.ErrInvalidConfig)
+	}
+	for _, field := range source.DateFilters {
+		if source.Dates[field] == nil {
+			return nil, fmt.Errorf("%w: date filter %s is not in Dates", assembly_go.ErrInvalidConfig, field)
+		}
+	}
+
+	cfg := config{pageSize: 100, stopAfter: 1, maxDays: 31, fullEvery: 7, now: time.Now}
+	for _, opt := range options {
+		opt(&cfg)
+	}
+	return &Syncer[T]{client: client, source: source, store: store, sink: sink, cfg: cfg}, nil
+}
+
+// Sync는 체크포인트 이후의 변경을 찾아 Sink에 전달하고 체크포인트를 갱신합니다.
+// 목록은 페이지마다 체크포인트를 저장하므로, 중간에 실패하면 다음 실행이 마지막으로 처리한 다음 페이지부터 이어갑니다.
+func (s *Syncer[T]) Sync(ctx context.Context) (Stats, error) {
+	var stats Stats
+	id := s.source.ID()
+	cp, err := s.store.Load(ctx, id)
+	if err != nil {
+		return stats, fmt.Errorf("failed to load checkpoint %s: %w", id, err)
+	}
+	if cp == nil {
+		cp = newCheckpoint(s.source.Endpoint, s.source.Scope)
+	}
+
+	now := s.cfg.now()
+	since := maps.Clone(cp.Cursors) // 날짜별 조회는 이번 실행 전에 본 날짜부터 시작합니다.
+	if cp.Page == 0 {
+		cp.Pass++
+		cp.Full = s.cfg.full || cp.Pass == 1 || s.stale(since, now) || (s.cfg.fullEvery > 0 && cp.Pass%s.cfg.fullEvery == 0)
+	}
+	stats.Full = cp.Full
+
+	if err := s.scan(ctx, cp, &stats); err != nil {
+		return stats, err
+	}
+	if !stats.Full {
+		if err := s.walkDates(ctx, cp, since, now, &stats); err != nil {
+			return stats, err
+		}
+	}
+	cp.SyncedAt = now
+	if err := s.save(ctx, cp); err != nil {
+		return stats, err
+	}
+	return stats, nil
+}
+
+// scan은 목록을 첫 페이지(또는 이어갈 페이지)부터 읽습니다.
+// 증분 동기화는 바뀐 행이 없는 페이지가 stopAfter번 연속으로 나오면 멈추고, 전체 조회는 끝까지 읽은 뒤 삭제된 행을 찾습니다.
+// 목록이 전체 행 수(list_total_count)에 닿기 전에 끝나면 끝까지 읽었다고 볼 수 없으므로 삭제된 행을 찾지 않습니다.
+func (s *Syncer[T]) scan(ctx context.Context, cp *Checkpoint, stats *Stats) error {
+	unchanged, reached, total := 0, 0, 0
+	for page := cp.Page + 1; ; page++ {
+		rows, n, err := s.fetch(ctx, nil, page, stats)
+		if err != nil {
+			return err
+		}
+		total, reached = n, (page-1)*s.cfg.pageSize+len(rows)
+		changed, err := s.apply(ctx, cp, rows, stats)
+		if err != nil {
+			return err
+		}
+		cp.Page = page
+		if err := s.save(ctx, cp); err != nil {
+			return err
+		}
+
+		if len(rows) < s.cfg.pageSize || (total > 0 && page*s.cfg.pageSize >= total) {
+			break
+		}
+		if changed == 0 {
+			unchanged++
+		} else {
+			unchanged = 0
+		}
+		if !cp.Full && unchanged >= s.cfg.stopAfter {
+			break
+		}
+	}
+
+	if cp.Full && total > 0 && reached >= total {
+		if err := s.removeUnseen(ctx, cp, stats); err != nil {
+			return err
+		}
+	}
+	cp.Page = 0
+	cp.Full = false
+	return s.save(ctx, cp)
+}
+
+// walkDates는 날짜 조건을 지원하는 필드마다 since의 날짜부터 now까지 하루씩 조회합니다.
+func (s *Syncer[T]) walkDates(ctx context.Context, cp *Checkpoint, since map[string]string, now time.Time, stats *Stats) error {
+	today := truncateDay(now)
+	for _, field := range s.source.DateFilters {
+		from, ok := parseDate(since[field])
+		if !ok {
+			continue
+		}
+		for day := from; !day.After(today); day = day.AddDate(0, 0, 1) {
+			filter := map[string]string{field: day.Format(dateLayout)}
+			for page := 1; ; page++ {
+				rows, total, err := s.fetch(ctx, filter, page, stats)
+				if err != nil {
+					return err
+				}
+				if _, err := s.apply(ctx, cp, rows, stats); err != nil {
+					return err
+				}
+				if len(rows) < s.cfg.pageSize || (total > 0 && page*s.cfg.pageSize >= total) {
+					break
+				}
+			}
+			if err := s.save(ctx, cp); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Syncer[T]) fetch(ctx context.Context, filter map[string]string, page int, stats *Stats) ([]T, int, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, 0, err
+	}
+	rows, total, err := s.source.Fetch(s.client, filter, page, s.cfg.pageSize)
+	if err != nil {
+		if len(filter) > 0 {
+			return nil, 0, fmt.Errorf("%s %v page %d: %w", s.source.ID(), filter, page, err)
+		}
+		return nil, 0, fmt.Errorf("%s page %d: %w", s.source.ID(), page, err)
+	}
+	stats.Pages++
+	stats.Rows += len(rows)
+	return rows, total, nil
+}
+
+// apply는 행을 체크포인트와 비교해 바뀐 행의 이벤트를 전달하고, 체크포인트의 해시와 커서를 갱신합니다.
+// 반환값은 이벤트를 전달한 행 수입니다.
+func (s *Syncer[T]) apply(ctx context.Context, cp *Checkpoint, rows []T, stats *Stats) (int, error) {
+	changed := 0
+	for _, row := range rows {
+		key := s.source.Key(row)
+		if key == "" {
+			continue
+		}
+		hash, err := fingerprint(row)
+		if err != nil {
+			return changed, err
+		}
+		for field, date := range s.source.Dates {
+			if v := date(row); v > cp.Cursors[field] {
+				cp.Cursors[field] = v
+			}
+		}
+
+		prev, known := cp.Rows[key]
+		cp.Rows[key] = RowState{Hash: hash, Pass: cp.Pass}
+		kind := Added
+		switch {
+		case known && prev.Hash == hash:
+			stats.Unchanged++
+			continue
+		case known:
+			kind = Updated
+		}
+		if err := s.emit(ctx, kind, key, row); err != nil {
+			return changed, err
+		}
+		if kind == Added {
+			stats.Added++
+		} else {
+			stats.Updated++
+		}
+		changed++
+	}
+	return changed, nil
+}
+
+// removeUnseen은 이번 전체 조회에서 보지 못한 행의 Removed 이벤트를 전달하고 체크포인트에서 지웁니다.
+func (s *Syncer[T]) removeUnseen(ctx context.Context, cp *Checkpoint, stats *Stats) error {
+	for key, state := range cp.Rows {
+		if state.Pass == cp.Pass {
+			continue
+		}
+		var zero T
+		if err := s.emit(ctx, Removed, key, zero); err != nil {
+			return err
+		}
+		delete(cp.Rows, key)
+		stats.Removed++
+	}
+	return nil
+}
+
+func (s *Syncer[T]) emit(ctx context.Context, kind ChangeKind, key string, row T) error {
+	event := Event[T]{Kind: kind, Endpoint: s.source.Endpoint, Scope: s.source.Scope, Key: key, Row: row}
+	if err := s.sink.Emit(ctx, event); err != nil {
+		return fmt.Errorf("sink rejected %s %s: %w", kind, key, err)
+	}
+	return nil
+}
+
+func (s *Syncer[T]) save(ctx context.Context, cp *Checkpoint) error {
+	if err := s.store.Save(ctx, s.source.ID(), cp); err != nil {
+		return fmt.Errorf("failed to save checkpoint %s: %w", s.source.ID(), err)
+	}
+	return nil
+}
+
+// stale은 날짜별 조회로 따라잡기에는 커서가 너무 오래되었는지 확인합니다.
+func (s *Syncer[T]) stale(cursors map[string]string, now time.Time) bool {
+	for _, field := range s.source.DateFilters {
+		from, ok := parseDate(cursors[field])
+		if ok && truncateDay(now).Sub(from) > time.Duration(s.cfg.maxDays)*24*time.Hour {
+			return true
+		}
+	}
+	return false
+}
+
+// fingerprint는 행을 JSON으로 인코딩한 내용의 해시입니다.
+func fingerprint(row any) (string, error) {
+	data, err := json.Marshal(row)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode row: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:16]), nil
+}
+
+// parseDate는 "2024-05-29"나 "20240529 ..." 형식의 앞부분을 날짜로 해석합니다.
+func parseDate(s string) (time.Time, bool) {
+	for _, layout := range []string{dateLayout, "20060102"} {
+		if len(s) >= len(layout) {
+			if t, err := time.Parse(layout, s[:len(layout)]); err == nil {
+				return t, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+func truncateDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,155 @@
+package incremental
+
+import (
+	"assembly_go"
+	"assembly_go/models"
+	"strconv"
+)
+
+// Source는 동기화할 엔드포인트 하나와 그 범위를 설명합니다.
+// Bills, Votes, Conferences, BillConferences가 SDK의 엔드포인트에 맞춰 채운 Source를 반환하며,
+// 다른 엔드포인트는 필드를 직접 채워 사용할 수 있습니다.
+type Source[T any] struct {
+	Endpoint string // 엔드포인트 이름 (예: "TVBPMBILL11")
+	Scope    string // 같은 엔드포인트 안에서 범위를 구분하는 값 (예: "AGE=22"). 체크포인트 id에 쓰입니다.
+
+	// Key는 행을 구분하는 값을 반환합니다. 빈 값을 반환한 행은 무시합니다.
+	Key func(row T) string
+
+	// Dates는 체크포인트에 커서로 기록할 날짜 필드와 그 값을 꺼내는 함수입니다.
+	Dates map[string]func(row T) string
+
+	// DateFilters는 Dates 중 엔드포인트가 일치 조건으로 지원하는 필드입니다.
+	// 증분 동기화 때 마지막 커서 날짜부터 오늘까지 하루씩 이 필드로 조회해, 오래된 행이 새로 바뀐 것(예: 의결)을 찾아냅니다.
+	DateFilters []string
+
+	// Fetch는 page 번째 페이지를 조회해 행과 전체 행 수를 반환합니다.
+	// RESULT 오류 응답(assembly_go.CheckResult)은 빈 페이지가 아니라 에러로 반환해야 합니다.
+	// 빈 페이지로 반환하면 전체 조회가 그 뒤의 행을 보지 못합니다.
+	// filter에는 DateFilters 중 하나의 필드와 날짜("2006-01-02")가 담기며, 일반 조회에서는 nil입니다.
+	Fetch func(client *assembly_go.Client, filter map[string]string, page, size int) ([]T, int, error)
+}
+
+// ID는 체크포인트 저장소에서 쓰는 식별자("<Endpoint>/<Scope>")입니다.
+func (s Source[T]) ID() string {
+	if s.Scope == "" {
+		return s.Endpoint
+	}
+	return s.Endpoint + "/" + s.Scope
+}
+
+// Bills는 age 대의 법률안(TVBPMBILL11)을 의안ID로 동기화하는 Source입니다.
+// 새 법안은 최신순 목록에서 찾고, 이미 받은 법안이 의결된 것은 PROC_DT(의결일) 조건으로 찾습니다.
+func Bills(age string) Source[models.TVBPMBILL11Row] {
+	return Source[models.TVBPMBILL11Row]{
+		Endpoint: "TVBPMBILL11",
+		Scope:    "AGE=" + age,
+		Key:      func(row models.TVBPMBILL11Row) string { return row.BillId },
+		Dates: map[string]func(models.TVBPMBILL11Row) string{
+			"PROPOSE_DT": func(row models.TVBPMBILL11Row) string { return row.ProposeDate },
+			"PROC_DT":    func(row models.TVBPMBILL11Row) string { return row.ResolutionDate },
+		},
+		DateFilters: []string{"PROC_DT"},
+		Fetch: func(client *assembly_go.Client, filter map[string]string, page, size int) ([]models.TVBPMBILL11Row, int, error) {
+			resp, err := client.FetchBillsWithOptions(
+				models.TVBPMBILL11RequestParams{Pindex: strconv.Itoa(page), Psize: strconv.Itoa(size)},
+				models.TVBPMBILL11OptionalParams{AGE: age, PROC_DT: filter["PROC_DT"]},
+			)
+			if err == nil {
+				err = assembly_go.CheckResult(resp)
+			}
+			if err != nil {
+				return nil, 0, err
+			}
+			return resp.AllRows(), resp.TotalCount(), nil
+		},
+	}
+}
+
+// Votes는 age 대 의안 billID의 본회의 표결(nojepdqqaweusdfbi)을 국회의원코드로 동기화하는 Source입니다.
+// Bills 동기화에서 의결된 법안의 이벤트를 받아 그 법안의 표결을 동기화하는 식으로 사용합니다.
+// 목록 순서와 무관하게 최근 표결의 변경은 VOTE_DATE(의결일자) 조건으로 찾습니다.
+func Votes(age, billID string) Source[models.NojepdqqaweusdfbiRow] {
+	return Source[models.NojepdqqaweusdfbiRow]{
+		Endpoint: "nojepdqqaweusdfbi",
+		Scope:    "AGE=" + age + "/BILL_ID=" + billID,
+		Key: func(row models.NojepdqqaweusdfbiRow) string {
+			if row.MemberCode != "" {
+				return row.MemberCode
+			}
+			return row.MemberNumber
+		},
+		Dates: map[string]func(models.NojepdqqaweusdfbiRow) string{
+			"VOTE_DATE": func(row models.NojepdqqaweusdfbiRow) string { return row.VoteDate },
+		},
+		DateFilters: []string{"VOTE_DATE"},
+		Fetch: func(client *assembly_go.Client, filter map[string]string, page, size int) ([]models.NojepdqqaweusdfbiRow, int, error) {
+			date := filter["VOTE_DATE"]
+			resp, err := client.FetchMemberVoteResultWithOptions(models.NojepdqqaweusdfbiRequestParams{
+				Pindex: strconv.Itoa(page), Psize: strconv.Itoa(size), AGE: age, BILL_ID: billID,
+			}, models.NojepdqqaweusdfbiOptionalParams{VOTE_DATE: &date})
+			if err == nil {
+				err = assembly_go.CheckResult(resp)
+			}
+			if err != nil {
+				return nil, 0, err
+			}
+			return resp.AllRows(), resp.TotalCount(), nil
+		},
+	}
+}
+
+// Conferences는 eraco 대(예: "제22대")의 회의록(VCONFPHCONFLIST)을 회의ID로 동기화하는 Source입니다.
+// 이미 받은 회의록이 바뀐 것(예: 다운로드 URL이 뒤늦게 채워짐)은 CONF_DT(회의일자) 조건으로 찾습니다.
+func Conferences(eraco string) Source[models.VCONFPHCONFLISTRow] {
+	return Source[models.VCONFPHCONFLISTRow]{
+		Endpoint: "VCONFPHCONFLIST",
+		Scope:    "ERACO=" + eraco,
+		Key:      func(row models.VCONFPHCONFLISTRow) string { return row.CONF_ID },
+		Dates: map[string]func(models.VCONFPHCONFLISTRow) string{
+			"CONF_DT": func(row models.VCONFPHCONFLISTRow) string { return row.CONF_DT },
+		},
+		DateFilters: []string{"CONF_DT"},
+		Fetch: func(client *assembly_go.Client, filter map[string]string, page, size int) ([]models.VCONFPHCONFLISTRow, int, error) {
+			date := filter["CONF_DT"]
+			resp, err := client.FetchMeetingConferenceListWithOptions(models.VCONFPHCONFLISTRequestParams{
+				Pindex: strconv.Itoa(page), Psize: strconv.Itoa(size), ERACO: eraco,
+			}, models.VCONFPHCONFLISTOptionalParams{CONF_DT: &date})
+			if err == nil {
+				err = assembly_go.CheckResult(resp)
+			}
+			if err != nil {
+				return nil, 0, err
+			}
+			return resp.AllRows(), resp.TotalCount(), nil
+		},
+	}
+}
+
+// BillConferences는 의안 billID의 회의록 목록(VCONFBILLCONFLIST)을 회의ID로 동기화하는 Source입니다.
+// 이미 받은 회의록이 바뀐 것은 Conferences와 같이 CONF_DT(회의일자) 조건으로 찾습니다.
+func BillConferences(billID string) Source[models.VCONFBILLCONFLISTRow] {
+	return Source[models.VCONFBILLCONFLISTRow]{
+		Endpoint: "VCONFBILLCONFLIST",
+		Scope:    "BILL_ID=" + billID,
+		Key:      func(row models.VCONFBILLCONFLISTRow) string { return row.ConferenceId },
+		Dates: map[string]func(models.VCONFBILLCONFLISTRow) string{
+			"CONF_DT": func(row models.VCONFBILLCONFLISTRow) string { return row.ConferenceDate },
+		},
+		DateFilters: []string{"CONF_DT"},
+		Fetch: func(client *assembly_go.Client, filter map[string]string, page, size int) ([]models.VCONFBILLCONFLISTRow, int, error) {
+			date := filter["CONF_DT"]
+			resp, err := client.FetchBillConferenceListWithOptions(
+				models.VCONFBILLCONFLISTRequestParams{Pindex: page, Psize: size, BILL_ID: billID},
+				models.VCONFBILLCONFLISTOptionalParams{CONF_DT: &date},
+			)
+			if err == nil {
+				err = assembly_go.CheckResult(resp)
+			}
+			if err != nil {
+				return nil, 0, err
+			}
+			return resp.AllRows(), resp.TotalCount(), nil
+		},
+	}
+}
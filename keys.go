@@ -0,0 +1,180 @@
+package assembly_go
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// KeyProvider는 API 요청에 사용할 인증키를 공급하는 인터페이스입니다.
+// Client는 요청마다 Key로 키를 받고, 응답의 RESULT 코드를 Report로 알려줍니다.
+// 여러 고루틴에서 동시에 호출될 수 있으므로 구현체는 동시성에 안전해야 합니다.
+type KeyProvider interface {
+	// Key는 지금 사용할 인증키를 반환합니다. 사용할 수 있는 키가 없으면 ErrNoAPIKey를 감싼 에러를 반환합니다.
+	Key() (string, error)
+	// Report는 key로 보낸 요청의 결과 코드를 알립니다. 코드를 확인할 수 없는 응답은 빈 문자열입니다.
+	Report(key string, code string)
+}
+
+// KeyUsage는 인증키 하나의 사용 현황입니다.
+type KeyUsage struct {
+	Key       string    // 인증키
+	Requests  int       // 이 키로 보낸 요청 수
+	Errors    int       // 에러 결과 코드를 받은 요청 수
+	LastCode  string    // 마지막으로 받은 결과 코드
+	Exhausted bool      // 오늘 호출 한도를 모두 사용했는지 여부
+	Invalid   bool      // 인증키 오류로 더 이상 사용하지 않는지 여부
+	LastUsed  time.Time // 마지막 사용 시각
+}
+
+// RotatingKeyProvider는 여러 인증키를 순서대로 사용하는 KeyProvider 구현체입니다.
+// 현재 키가 호출 한도 초과 코드를 받으면 다음 키로 넘어가며, 한도가 초과된 키는
+// 한국 표준시(KST) 기준으로 날짜가 바뀌면 다시 사용할 수 있습니다.
+// 인증키 오류 코드를 받은 키는 Reset을 호출하기 전까지 사용하지 않습니다.
+type RotatingKeyProvider struct {
+	mu      sync.Mutex
+	keys    []string
+	usage   map[string]*keyState
+	current int
+	now     func() time.Time
+}
+
+type keyState struct {
+	KeyUsage
+	exhaustedOn string // 한도 초과를 받은 날짜 (KST, YYYYMMDD)
+}
+
+// kst는 국회 OpenAPI의 일별 호출 한도 기준 시간대입니다.
+var kst = time.FixedZone("KST", 9*60*60)
+
+// NewRotatingKeyProvider는 keys를 순서대로 사용하는 RotatingKeyProvider를 생성합니다.
+// 빈 문자열과 중복된 키는 제외합니다.
+func NewRotatingKeyProvider(keys ...string) *RotatingKeyProvider {
+	p := &RotatingKeyProvider{usage: make(map[string]*keyState), now: time.Now}
+	for _, key := range keys {
+		key = strings.TrimSpace(key)
+		if key == "" || p.usage[key] != nil {
+			continue
+		}
+		p.keys = append(p.keys, key)
+		p.usage[key] = &keyState{KeyUsage: KeyUsage{Key: key}}
+	}
+	return p
+}
+
+// Len은 등록된 인증키 수를 반환합니다.
+func (p *RotatingKeyProvider) Len() int {
+	return len(p.keys)
+}
+
+// Key는 현재 순번부터 사용 가능한 첫 번째 인증키를 반환합니다.
+func (p *RotatingKeyProvider) Key() (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	today := p.now().In(kst).Format("20060102")
+	for i := 0; i < len(p.keys); i++ {
+		idx := (p.current + i) % len(p.keys)
+		state := p.usage[p.keys[idx]]
+		if state.Exhausted && state.exhaustedOn != today {
+			state.Exhausted = false // 날짜가 바뀌어 한도가 초기화되었습니다.
+		}
+		if state.Invalid || state.Exhausted {
+			continue
+		}
+		p.current = idx
+		return state.Key, nil
+	}
+	return "", fmt.Errorf("%w: all %d keys are exhausted or invalid", ErrNoAPIKey, len(p.keys))
+}
+
+// Report는 결과 코드를 기록하고, 한도 초과나 인증키 오류라면 다음 키로 순번을 넘깁니다.
+func (p *RotatingKeyProvider) Report(key string, code string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	state, ok := p.usage[key]
+	if !ok {
+		return
+	}
+	now := p.now()
+	state.Requests++
+	state.LastCode = code
+	state.LastUsed = now
+	if isErrorCode(code) {
+		state.Errors++
+	}
+
+	switch {
+	case IsKeyQuotaCode(code):
+		state.Exhausted = true
+		state.exhaustedOn = now.In(kst).Format("20060102")
+	case IsInvalidKeyCode(code):
+		state.Invalid = true
+	default:
+		return
+	}
+	if p.keys[p.current] == key {
+		p.current = (p.current + 1) % len(p.keys)
+	}
+}
+
+// Usage는 등록 순서대로 각 인증키의 사용 현황을 반환합니다.
+func (p *RotatingKeyProvider) Usage() []KeyUsage {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	usage := make([]KeyUsage, 0, len(p.keys))
+	for _, key := range p.keys {
+		usage = append(usage, p.usage[key].KeyUsage)
+	}
+	return usage
+}
+
+// Reset은 모든 키의 한도 초과 및 인증키 오류 상태를 지우고 첫 번째 키부터 다시 사용합니다.
+// 요청 수 등 누적 사용량은 유지됩니다.
+func (p *RotatingKeyProvider) Reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, state := range p.usage {
+		state.Exhausted = false
+		state.Invalid = false
+		state.exhaustedOn = ""
+	}
+	p.current = 0
+}
+
+// LoadAPIKeysFile은 비밀 파일에서 인증키 목록을 읽습니다.
+// 파일은 JSON 문자열 배열이거나, 한 줄에 키 하나씩 적은 텍스트입니다.
+// 텍스트 형식에서는 빈 줄과 '#'으로 시작하는 주석 줄을 무시합니다.
+func LoadAPIKeysFile(path string) ([]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read API key file: %w", err)
+	}
+
+	var keys []string
+	if trimmed := bytes.TrimSpace(data); bytes.HasPrefix(trimmed, []byte("[")) {
+		if err := json.Unmarshal(trimmed, &keys); err != nil {
+			return nil, fmt.Errorf("failed to parse API key file %s: %w", path, err)
+		}
+	} else {
+		for _, line := range strings.Split(string(data), "\n") {
+			line = strings.TrimSpace(line)
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			keys = append(keys, line)
+		}
+	}
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("%w: no keys in %s", ErrNoAPIKey, path)
+	}
+	return keys, nil
+}
@@ -0,0 +1,286 @@
+// Package lifecycle은 법안(TVBPMBILL11) 행의 날짜 필드로 법안의 현재 단계, 단계 전환 타임라인,
+// 단계별 머문 기간을 계산하고 날짜 순서가 맞지 않는 행을 찾아냅니다.
+//
+//	lc := lifecycle.Derive(row, time.Now())
+//	fmt.Println(lc.Stage, lc.CurrentDays(time.Now()))
+//
+//	// 소관위원회에 90일 넘게 머문 법안
+//	stuck := lifecycle.Stuck(rows, time.Now(), 90, lifecycle.CommitteeStages...)
+package lifecycle
+
+import (
+	"assembly_go/models"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Stage는 법안의 심사 단계입니다.
+type Stage string
+
+const (
+	StageUnknown         Stage = "unknown"          // 날짜가 하나도 없음
+	StageProposed        Stage = "proposed"         // 접수 (PROPOSE_DT)
+	StageCommittee       Stage = "committee"        // 소관위 회부 (COMMITTEE_DT)
+	StageCommitteeReview Stage = "committee_review" // 소관위 상정 (CMT_PRESENT_DT)
+	StageCommitteeDone   Stage = "committee_done"   // 소관위 처리, 법사위 회부 대기 (CMT_PROC_DT, 없으면 COMMITTEE_PROC_DT)
+	StageJudiciary       Stage = "judiciary"        // 법사위 회부 (LAW_SUBMIT_DT)
+	StageJudiciaryReview Stage = "judiciary_review" // 법사위 상정 (LAW_PRESENT_DT)
+	StageJudiciaryDone   Stage = "judiciary_done"   // 법사위 처리, 본회의 대기 (LAW_PROC_DT)
+	StageDecided         Stage = "decided"          // 본회의 의결 (PROC_DT)
+	StageClosed          Stage = "closed"           // 위원회 단계에서 폐기, 철회 등으로 끝남
+)
+
+// CommitteeStages는 소관위원회에 있는 단계입니다. Stuck에 넘겨 "소관위에 머문 법안"을 찾을 때 씁니다.
+var CommitteeStages = []Stage{StageCommittee, StageCommitteeReview}
+
+// JudiciaryStages는 법제사법위원회 체계·자구 심사 중이거나 회부를 기다리는 단계입니다.
+var JudiciaryStages = []Stage{StageCommitteeDone, StageJudiciary, StageJudiciaryReview}
+
+var stageLabels = map[Stage]string{
+	StageUnknown:         "알 수 없음",
+	StageProposed:        "접수",
+	StageCommittee:       "소관위 회부",
+	StageCommitteeReview: "소관위 심사",
+	StageCommitteeDone:   "소관위 처리",
+	StageJudiciary:       "법사위 회부",
+	StageJudiciaryReview: "법사위 심사",
+	StageJudiciaryDone:   "법사위 처리",
+	StageDecided:         "본회의 의결",
+	StageClosed:          "종료",
+}
+
+// Label은 단계의 한글 이름입니다.
+func (s Stage) Label() string {
+	if label, ok := stageLabels[s]; ok {
+		return label
+	}
+	return string(s)
+}
+
+// Terminal은 더 진행되지 않는 단계인지 알려줍니다.
+func (s Stage) Terminal() bool {
+	return s == StageDecided || s == StageClosed
+}
+
+// milestone은 단계가 시작되는 날짜 필드입니다. 순서대로 진행됩니다.
+type milestone struct {
+	stage  Stage
+	fields []string // 앞의 필드가 비어있으면 다음 필드를 씁니다.
+	date   func(models.TVBPMBILL11Row) []string
+}
+
+var milestones = []milestone{
+	{StageProposed, []string{"PROPOSE_DT"}, func(r models.TVBPMBILL11Row) []string { return []string{r.ProposeDate} }},
+	{StageCommittee, []string{"COMMITTEE_DT"}, func(r models.TVBPMBILL11Row) []string { return []string{r.SubmitDate} }},
+	{StageCommitteeReview, []string{"CMT_PRESENT_DT"}, func(r models.TVBPMBILL11Row) []string { return []string{deref(r.CommitteePresentDate)} }},
+	{StageCommitteeDone, []string{"CMT_PROC_DT", "COMMITTEE_PROC_DT"}, func(r models.TVBPMBILL11Row) []string {
+		return []string{deref(r.CommitteeProcessDate), deref(r.CommitteeReviewProcessDate)}
+	}},
+	{StageJudiciary, []string{"LAW_SUBMIT_DT"}, func(r models.TVBPMBILL11Row) []string { return []string{deref(r.LegislationAndJudiciarySubmitDate)} }},
+	{StageJudiciaryReview, []string{"LAW_PRESENT_DT"}, func(r models.TVBPMBILL11Row) []string { return []string{deref(r.LegislationAndJudiciaryPresentDate)} }},
+	{StageJudiciaryDone, []string{"LAW_PROC_DT"}, func(r models.TVBPMBILL11Row) []string { return []string{deref(r.LegislationAndJudiciaryProcessDate)} }},
+	{StageDecided, []string{"PROC_DT"}, func(r models.TVBPMBILL11Row) []string { return []string{r.ResolutionDate} }},
+}
+
+// closingResults는 위원회 처리 결과 중 법안이 더 진행되지 않는 값입니다.
+var closingResults = []string{"폐기", "철회", "부결"}
+
+// kst는 포털 날짜의 기준 시간대입니다.
+var kst = time.FixedZone("KST", 9*60*60)
+
+// Transition은 타임라인의 한 단계 전환입니다.
+type Transition struct {
+	Stage Stage     `json:"stage"`
+	Label string    `json:"label"`
+	Field string    `json:"field"` // 날짜를 가져온 필드
+	Date  time.Time `json:"date"`
+}
+
+// Span은 한 단계에 머문 기간입니다. 현재 단계는 End가 0이고 Days는 Derive에 준 now까지의 일수입니다.
+type Span struct {
+	Stage Stage     `json:"stage"`
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end,omitzero"`
+	Days  int       `json:"days"`
+}
+
+// Issue는 날짜 필드의 문제입니다.
+type Issue struct {
+	Field   string `json:"field"`
+	Value   string `json:"value"`
+	Message string `json:"message"`
+}
+
+func (i Issue) String() string {
+	return fmt.Sprintf("%s=%s: %s", i.Field, i.Value, i.Message)
+}
+
+// Lifecycle은 법안 하나의 진행 상황입니다.
+type Lifecycle struct {
+	BillID   string       `json:"bill_id"`
+	BillName string       `json:"bill_name"`
+	Stage    Stage        `json:"stage"`            // 현재 단계
+	Result   string       `json:"result,omitempty"` // 본회의 심의결과, 위원회에서 끝났으면 그 처리결과
+	Timeline []Transition `json:"timeline"`         // 진행 순서
+	Spans    []Span       `json:"spans"`
+	Issues   []Issue      `json:"issues,omitempty"`
+}
+
+// Valid는 날짜 필드에 문제가 없는지 알려줍니다.
+func (l *Lifecycle) Valid() bool {
+	return len(l.Issues) == 0
+}
+
+// Since는 현재 단계가 시작된 날짜입니다. 날짜가 없으면 0입니다.
+func (l *Lifecycle) Since() time.Time {
+	if len(l.Timeline) == 0 {
+		return time.Time{}
+	}
+	return l.Timeline[len(l.Timeline)-1].Date
+}
+
+// CurrentDays는 현재 단계에 머문 일수입니다. 끝난 법안이나 날짜가 없는 법안은 0입니다.
+func (l *Lifecycle) CurrentDays(now time.Time) int {
+	if l.Stage.Terminal() || len(l.Timeline) == 0 {
+		return 0
+	}
+	return days(l.Since(), now)
+}
+
+// DaysIn은 stage에 머문 일수의 합입니다.
+func (l *Lifecycle) DaysIn(stage Stage) int {
+	total := 0
+	for _, s := range l.Spans {
+		if s.Stage == stage {
+			total += s.Days
+		}
+	}
+	return total
+}
+
+// Derive는 row의 날짜 필드로 Lifecycle을 계산합니다. now는 현재 단계의 기간과 미래 날짜 검사에 씁니다.
+//
+// 현재 단계는 날짜가 있는 가장 뒤의 단계입니다. 위원장 대안처럼 앞 단계를 건너뛰는 법안이 있으므로 빈 날짜는 문제로 보지 않지만,
+// 읽을 수 없는 날짜, 앞 단계보다 이른 날짜, now 이후의 날짜는 Issues에 남깁니다.
+// 소관위나 법사위 처리결과가 폐기, 철회, 부결이고 그 뒤 날짜가 없으면 그 처리일에 StageClosed로 끝납니다.
+func Derive(row models.TVBPMBILL11Row, now time.Time) Lifecycle {
+	lc := Lifecycle{BillID: row.BillId, BillName: row.BillName, Stage: StageUnknown}
+	today := day(now)
+
+	var latest time.Time
+	latestField := ""
+	for _, m := range milestones {
+		values := m.date(row)
+		for i, raw := range values {
+			raw = strings.TrimSpace(raw)
+			if raw == "" {
+				continue
+			}
+			field := m.fields[i]
+			date, ok := parseDate(raw)
+			if !ok {
+				lc.Issues = append(lc.Issues, Issue{Field: field, Value: raw, Message: "날짜 형식이 아닙니다"})
+				continue
+			}
+			if date.After(today) {
+				lc.Issues = append(lc.Issues, Issue{Field: field, Value: raw, Message: "미래 날짜입니다"})
+			}
+			if date.Before(latest) {
+				lc.Issues = append(lc.Issues, Issue{Field: field, Value: raw, Message: fmt.Sprintf("%s보다 이릅니다", latestField)})
+			} else {
+				latest, latestField = date, field
+			}
+			lc.Timeline = append(lc.Timeline, Transition{Stage: m.stage, Label: m.stage.Label(), Field: field, Date: date})
+			break
+		}
+	}
+
+	if n := len(lc.Timeline); n > 0 {
+		lc.Stage = lc.Timeline[n-1].Stage
+	}
+	switch lc.Stage {
+	case StageDecided:
+		lc.Result = strings.TrimSpace(row.PlenarySessionReviewResult)
+	case StageCommitteeDone:
+		if r := strings.TrimSpace(deref(row.CommitteeProcessResult)); closing(r) {
+			lc.close(r)
+		}
+	case StageJudiciaryDone:
+		if r := strings.TrimSpace(deref(row.LegislationAndJudiciaryProcessResult)); closing(r) {
+			lc.close(r)
+		}
+	}
+
+	for i, t := range lc.Timeline {
+		span := Span{Stage: t.Stage, Start: t.Date}
+		switch {
+		case i+1 < len(lc.Timeline):
+			span.End = lc.Timeline[i+1].Date
+			span.Days = max(days(span.Start, span.End), 0)
+		case t.Stage.Terminal():
+			span.End = t.Date
+		default:
+			span.Days = max(days(span.Start, today), 0)
+		}
+		lc.Spans = append(lc.Spans, span)
+	}
+	return lc
+}
+
+// close는 마지막 전환을 StageClosed로 바꿉니다.
+func (l *Lifecycle) close(result string) {
+	last := &l.Timeline[len(l.Timeline)-1]
+	last.Stage, last.Label = StageClosed, StageClosed.Label()
+	l.Stage, l.Result = StageClosed, result
+}
+
+// Stuck은 현재 단계가 stages 중 하나이고 그 단계에 minDays일 넘게 머문 법안을 오래된 순으로 반환합니다.
+func Stuck(rows []models.TVBPMBILL11Row, now time.Time, minDays int, stages ...Stage) []Lifecycle {
+	var stuck []Lifecycle
+	for _, row := range rows {
+		lc := Derive(row, now)
+		if slices.Contains(stages, lc.Stage) && lc.CurrentDays(now) > minDays {
+			stuck = append(stuck, lc)
+		}
+	}
+	slices.SortStableFunc(stuck, func(a, b Lifecycle) int { return a.Since().Compare(b.Since()) })
+	return stuck
+}
+
+func closing(result string) bool {
+	for _, r := range closingResults {
+		if strings.Contains(result, r) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseDate는 "2006-01-02", "20060102", "2006.01.02" 형식을 읽습니다.
+func parseDate(s string) (time.Time, bool) {
+	for _, layout := range []string{"2006-01-02", "20060102", "2006.01.02"} {
+		if t, err := time.ParseInLocation(layout, s, kst); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// day는 t를 한국 시간 기준 그날 0시로 바꿉니다.
+func day(t time.Time) time.Time {
+	t = t.In(kst)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, kst)
+}
+
+func days(from, to time.Time) int {
+	return int(day(to).Sub(day(from)).Hours() / 24)
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,512 @@
+package localstore
+
+import (
+	"assembly_go"
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Order는 정렬 기준 하나입니다. 두 값이 모두 숫자면 숫자로, 아니면 문자열로 비교합니다.
+type Order struct {
+	Field string // JSON 필드 이름
+	Desc  bool   // 내림차순
+}
+
+// Range는 필드 값의 범위 조건입니다. 양 끝을 포함하며, 빈 From이나 To는 그쪽으로 제한이 없다는 뜻입니다.
+// 날짜 필드("2024-06-01")처럼 문자열 순서가 값의 순서와 같은 필드에 사용합니다. 값이 비어있는 행은 제외됩니다.
+type Range struct {
+	Field string
+	From  string
+	To    string
+}
+
+// Query는 Find의 조회 조건입니다.
+type Query struct {
+	Where   map[string]string // 필드 이름 → 값. 모두 정확히 일치해야 합니다 (OpenAPI 필터와 같음).
+	Ranges  []Range
+	OrderBy []Order // 비어있으면 컬렉션의 기본 정렬을 사용합니다. 마지막에는 항상 Key 오름차순으로 정렬합니다.
+	Page    int     // 1부터 시작하는 페이지. 0이면 1입니다.
+	Size    int     // 페이지 크기. 0이면 조건에 맞는 모든 행을 반환합니다.
+}
+
+// Result는 Find의 결과입니다.
+type Result[T any] struct {
+	Rows  []T
+	Total int // 페이지를 나누기 전 조건에 맞는 행 수
+}
+
+// record는 로그 파일의 한 줄입니다.
+type record[T any] struct {
+	Op  string `json:"op"` // "put" 또는 "del"
+	Key string `json:"key,omitempty"`
+	Row *T     `json:"row,omitempty"`
+}
+
+type entry[T any] struct {
+	row    T
+	fields map[string]string
+}
+
+// Collection은 한 종류의 행을 Key별로 보관하고, 색인을 이용해 조회합니다.
+// 변경은 컬렉션 파일(JSON Lines)에 덧붙여 기록되며, Store.Compact가 최신 상태만 남기도록 다시 씁니다.
+type Collection[T any] struct {
+	name     string
+	key      func(T) string
+	keyField string // Key가 필드 하나이면 그 이름. Where에 쓰면 색인 대신 바로 찾습니다.
+	indexed  []string
+	order    []Order
+	fieldSet map[string]bool
+
+	mu      sync.RWMutex
+	rows    map[string]*entry[T]
+	index   map[string]map[string]map[string]struct{} // 필드 → 값 → Key 집합
+	path    string
+	file    *os.File
+	w       *bufio.Writer
+	records int // 로그 줄 수
+}
+
+// spec은 컬렉션의 Key, 색인, 기본 정렬입니다.
+type spec[T any] struct {
+	name     string
+	key      func(T) string
+	keyField string
+	indexed  []string
+	order    []Order
+}
+
+func openCollection[T any](dir string, s spec[T]) (*Collection[T], error) {
+	c := &Collection[T]{
+		name:     s.name,
+		key:      s.key,
+		keyField: s.keyField,
+		indexed:  s.indexed,
+		order:    s.order,
+		fieldSet: make(map[string]bool),
+		rows:     make(map[string]*entry[T]),
+		index:    make(map[string]map[string]map[string]struct{}),
+		path:     filepath.Join(dir, s.name+".jsonl"),
+	}
+	for _, name := range fieldNames[T]() {
+		c.fieldSet[name] = true
+	}
+	for _, name := range s.indexed {
+		c.index[name] = make(map[string]map[string]struct{})
+	}
+
+	f, err := os.OpenFile(c.path, os.O_CREATE|os.O_RDWR, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.load(f); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if _, err := f.Seek(0, io.SeekEnd); err != nil {
+		f.Close()
+		return nil, err
+	}
+	c.file = f
+	c.w = bufio.NewWriter(f)
+	return c, nil
+}
+
+// load는 로그를 처음부터 읽어 메모리에 반영합니다.
+// 쓰는 도중 중단되어 마지막 줄이 잘렸으면 그 줄을 잘라내고, 줄바꿈만 빠졌으면 줄바꿈을 채웁니다.
+// 그 밖의 손상은 에러로 반환합니다.
+func (c *Collection[T]) load(f *os.File) error {
+	r := bufio.NewReader(f)
+	var offset int64
+	for {
+		line, err := r.ReadBytes('\n')
+		if len(line) > 0 {
+			var rec record[T]
+			if jsonErr := json.Unmarshal(bytes.TrimSpace(line), &rec); jsonErr != nil {
+				if err == io.EOF { // 줄바꿈 없이 끝난 마지막 줄
+					return f.Truncate(offset)
+				}
+				return fmt.Errorf("localstore: %s line %d: %w", c.path, c.records+1, jsonErr)
+			}
+			c.apply(rec)
+			c.records++
+			offset += int64(len(line))
+			if err == io.EOF && line[len(line)-1] != '\n' {
+				// 줄바꿈만 빠진 온전한 마지막 줄은 살리고, 다음 기록이 같은 줄에 이어 붙지 않게 줄바꿈을 채웁니다.
+				if _, err := f.Write([]byte{'\n'}); err != nil {
+					return err
+				}
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (c *Collection[T]) apply(rec record[T]) {
+	switch {
+	case rec.Op == "put" && rec.Row != nil:
+		c.put(c.key(*rec.Row), *rec.Row)
+	case rec.Op == "del":
+		c.remove(rec.Key)
+	}
+}
+
+// Len은 보관 중인 행 수를 반환합니다.
+func (c *Collection[T]) Len() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return len(c.rows)
+}
+
+// Get은 key의 행을 반환합니다.
+func (c *Collection[T]) Get(key string) (T, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	e, ok := c.rows[key]
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	return e.row, true
+}
+
+// Put은 행을 추가하거나 같은 Key의 행을 바꿉니다. 내용이 같은 행은 다시 기록하지 않습니다.
+func (c *Collection[T]) Put(rows ...T) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, row := range rows {
+		key := c.key(row)
+		if key == "" {
+			return fmt.Errorf("%w: %s row has an empty key", assembly_go.ErrInvalidID, c.name)
+		}
+		if prev, ok := c.rows[key]; ok && maps.Equal(prev.fields, fieldsOf(row)) {
+			continue
+		}
+		if err := c.append(record[T]{Op: "put", Row: &row}); err != nil {
+			return err
+		}
+		c.put(key, row)
+	}
+	return c.w.Flush()
+}
+
+// Delete는 keys의 행을 지웁니다. 없는 Key는 무시합니다.
+func (c *Collection[T]) Delete(keys ...string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, key := range keys {
+		if _, ok := c.rows[key]; !ok {
+			continue
+		}
+		if err := c.append(record[T]{Op: "del", Key: key}); err != nil {
+			return err
+		}
+		c.remove(key)
+	}
+	return c.w.Flush()
+}
+
+// Write는 Put과 같습니다. sink.Sink로 써서 sink.Copy(store.Bills(), pages)처럼 조회 결과를 바로 저장할 수 있습니다.
+func (c *Collection[T]) Write(row T) error {
+	return c.Put(row)
+}
+
+// Close는 sink.Sink를 구현하기 위한 것으로 아무것도 닫지 않습니다. 파일은 Store.Close가 닫습니다.
+func (c *Collection[T]) Close() error {
+	return nil
+}
+
+func (c *Collection[T]) append(rec record[T]) error {
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	if _, err := c.w.Write(append(data, '\n')); err != nil {
+		return err
+	}
+	c.records++
+	return nil
+}
+
+func (c *Collection[T]) put(key string, row T) {
+	c.remove(key)
+	e := &entry[T]{row: row, fields: fieldsOf(row)}
+	c.rows[key] = e
+	for _, name := range c.indexed {
+		v := e.fields[name]
+		keys := c.index[name][v]
+		if keys == nil {
+			keys = make(map[string]struct{})
+			c.index[name][v] = keys
+		}
+		keys[key] = struct{}{}
+	}
+}
+
+func (c *Collection[T]) remove(key string) {
+	e, ok := c.rows[key]
+	if !ok {
+		return
+	}
+	for _, name := range c.indexed {
+		v := e.fields[name]
+		delete(c.index[name][v], key)
+		if len(c.index[name][v]) == 0 {
+			delete(c.index[name], v)
+		}
+	}
+	delete(c.rows, key)
+}
+
+// Find는 q에 맞는 행을 정렬하고 페이지로 나누어 반환합니다. 행에 없는 필드 이름을 쓰면 에러를 반환합니다.
+func (c *Collection[T]) Find(q Query) (Result[T], error) {
+	for name := range q.Where {
+		if !c.fieldSet[name] {
+			return Result[T]{}, fmt.Errorf("%w: %s has no field %q", assembly_go.ErrInvalidConfig, c.name, name)
+		}
+	}
+	for _, r := range q.Ranges {
+		if !c.fieldSet[r.Field] {
+			return Result[T]{}, fmt.Errorf("%w: %s has no field %q", assembly_go.ErrInvalidConfig, c.name, r.Field)
+		}
+	}
+	order := q.OrderBy
+	if len(order) == 0 {
+		order = c.order
+	}
+	for _, o := range order {
+		if !c.fieldSet[o.Field] {
+			return Result[T]{}, fmt.Errorf("%w: %s has no field %q", assembly_go.ErrInvalidConfig, c.name, o.Field)
+		}
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var matched []string
+	for _, key := range c.candidates(q) {
+		if c.match(c.rows[key], q) {
+			matched = append(matched, key)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		a, b := c.rows[matched[i]].fields, c.rows[matched[j]].fields
+		for _, o := range order {
+			if cmp := compareValues(a[o.Field], b[o.Field]); cmp != 0 {
+				return (cmp < 0) != o.Desc
+			}
+		}
+		return matched[i] < matched[j]
+	})
+
+	result := Result[T]{Total: len(matched)}
+	if q.Size > 0 {
+		page := max(q.Page, 1)
+		start := min((page-1)*q.Size, len(matched))
+		matched = matched[start:min(start+q.Size, len(matched))]
+	}
+	result.Rows = make([]T, len(matched))
+	for i, key := range matched {
+		result.Rows[i] = c.rows[key].row
+	}
+	return result, nil
+}
+
+// candidates는 조건을 만족할 수 있는 Key를 가장 좁은 색인으로 고릅니다. 쓸 수 있는 색인이 없으면 모든 Key입니다.
+func (c *Collection[T]) candidates(q Query) []string {
+	if c.keyField != "" {
+		if v, ok := q.Where[c.keyField]; ok {
+			if _, exists := c.rows[v]; exists {
+				return []string{v}
+			}
+			return nil
+		}
+	}
+
+	var best map[string]struct{}
+	found := false
+	for name, v := range q.Where {
+		if idx, ok := c.index[name]; ok {
+			if keys := idx[v]; !found || len(keys) < len(best) {
+				best, found = keys, true
+			}
+		}
+	}
+	if !found {
+		for _, r := range q.Ranges {
+			idx, ok := c.index[r.Field]
+			if !ok {
+				continue
+			}
+			best, found = make(map[string]struct{}), true
+			for v, keys := range idx {
+				if inRange(v, r) {
+					for k := range keys {
+						best[k] = struct{}{}
+					}
+				}
+			}
+			break
+		}
+	}
+
+	var keys []string
+	if found {
+		keys = make([]string, 0, len(best))
+		for k := range best {
+			keys = append(keys, k)
+		}
+		return keys
+	}
+	keys = make([]string, 0, len(c.rows))
+	for k := range c.rows {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func (c *Collection[T]) match(e *entry[T], q Query) bool {
+	for name, v := range q.Where {
+		if e.fields[name] != v {
+			return false
+		}
+	}
+	for _, r := range q.Ranges {
+		if !inRange(e.fields[r.Field], r) {
+			return false
+		}
+	}
+	return true
+}
+
+// compact는 현재 행만 담은 로그를 임시 파일에 쓴 뒤 이름을 바꿉니다.
+func (c *Collection[T]) compact() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.w.Flush(); err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(c.rows))
+	for k := range c.rows {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	tmp, err := os.CreateTemp(filepath.Dir(c.path), "."+c.name+".*.tmp")
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(tmp)
+	enc := json.NewEncoder(w)
+	for _, k := range keys {
+		row := c.rows[k].row
+		if err := enc.Encode(record[T]{Op: "put", Row: &row}); err != nil {
+			tmp.Close()
+			os.Remove(tmp.Name())
+			return err
+		}
+	}
+	if err := errors.Join(w.Flush(), tmp.Sync(), tmp.Close()); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := os.Rename(tmp.Name(), c.path); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	f, err := os.OpenFile(c.path, os.O_RDWR|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	c.file.Close()
+	c.file, c.w, c.records = f, bufio.NewWriter(f), len(keys)
+	return nil
+}
+
+func (c *Collection[T]) close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return errors.Join(c.w.Flush(), c.file.Close())
+}
+
+func inRange(v string, r Range) bool {
+	if v == "" {
+		return false
+	}
+	return (r.From == "" || v >= r.From) && (r.To == "" || v <= r.To)
+}
+
+// compareValues는 두 값이 모두 정수면 숫자로, 아니면 문자열로 비교합니다. AGE처럼 "9"와 "22"가 섞인 필드를 위해서입니다.
+func compareValues(a, b string) int {
+	if x, err := strconv.ParseInt(a, 10, 64); err == nil {
+		if y, err := strconv.ParseInt(b, 10, 64); err == nil {
+			switch {
+			case x < y:
+				return -1
+			case x > y:
+				return 1
+			}
+			return 0
+		}
+	}
+	return strings.Compare(a, b)
+}
+
+// fieldNames는 T의 JSON 필드 이름입니다.
+func fieldNames[T any]() []string {
+	t := reflect.TypeFor[T]()
+	var names []string
+	for i := range t.NumField() {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// fieldsOf는 행을 JSON 필드 이름별 문자열 값으로 바꿉니다. nil 포인터는 빈 문자열입니다.
+func fieldsOf(row any) map[string]string {
+	fields := make(map[string]string)
+	v := reflect.ValueOf(row)
+	t := v.Type()
+	for i := range t.NumField() {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		field := v.Field(i)
+		if field.Kind() == reflect.Pointer {
+			if field.IsNil() {
+				fields[name] = ""
+				continue
+			}
+			field = field.Elem()
+		}
+		switch value := field.Interface().(type) {
+		case json.Number:
+			fields[name] = value.String()
+		default:
+			fields[name] = fmt.Sprint(value)
+		}
+	}
+	return fields
+}
@@ -0,0 +1,142 @@
+package localstore
+
+import (
+	"assembly_go"
+	"assembly_go/models"
+	"assembly_go/terms"
+	"strconv"
+)
+
+const (
+	defaultPageSize = 10
+	okCode          = "INFO-000"
+	okMessage       = "정상 처리되었습니다."
+)
+
+// find는 요청 인자를 OpenAPI와 같은 방식으로 해석해 조회합니다.
+// 값이 있는 인자 중 행에 있는 필드는 정확히 일치해야 하고, pIndex/pSize로 페이지를 나눕니다.
+// OpenAPI가 필수로 요구하는 인자(예: 표결의 AGE, BILL_ID)가 없어도 에러 없이 전체에서 찾습니다.
+// 대수 인자(AGE, DAESU, ERACO)는 Client처럼 terms.NormalizeParam으로 저장된 형식에 맞춥니다 ("22" → "제22대").
+func find[T any](c *Collection[T], params ...any) ([]T, int, error) {
+	q := Query{Where: make(map[string]string), Page: 1, Size: defaultPageSize}
+	for _, p := range params {
+		values, err := assembly_go.StructToMapString(p)
+		if err != nil {
+			return nil, 0, err
+		}
+		for name, v := range values {
+			switch {
+			case name == "pIndex":
+				if n, err := strconv.Atoi(v); err == nil && n > 0 {
+					q.Page = n
+				}
+			case name == "pSize":
+				if n, err := strconv.Atoi(v); err == nil && n > 0 {
+					q.Size = n
+				}
+			case v != "" && c.fieldSet[name]:
+				q.Where[name] = terms.NormalizeParam(name, v)
+			}
+		}
+	}
+	result, err := c.Find(q)
+	if err != nil {
+		return nil, 0, err
+	}
+	return result.Rows, result.Total, nil
+}
+
+// FetchBills는 저장된 법안을 조회합니다. 최근 제안일 순입니다.
+func (s *Store) FetchBills(params models.TVBPMBILL11RequestParams) (*models.TVBPMBILL11Response, error) {
+	return s.FetchBillsWithOptions(params, models.TVBPMBILL11OptionalParams{})
+}
+
+// FetchBillsWithOptions는 선택 인자로 걸러 저장된 법안을 조회합니다.
+func (s *Store) FetchBillsWithOptions(params models.TVBPMBILL11RequestParams, optional models.TVBPMBILL11OptionalParams) (*models.TVBPMBILL11Response, error) {
+	rows, total, err := find(s.bills, params, optional)
+	if err != nil {
+		return nil, err
+	}
+	resp := &models.TVBPMBILL11Response{}
+	if len(rows) > 0 {
+		resp.TVBPMBILL11 = []models.TVBPMBILL11{
+			{Head: []models.TVBPMBILL11Head{{ListTotalCount: total}, {Result: models.TVBPMBILL11Result{Code: okCode, Message: okMessage}}}},
+			{Rows: rows},
+		}
+	}
+	return resp, nil
+}
+
+// FetchBillConferenceList는 저장된 의안별 회의록을 조회합니다. 회의 날짜 순입니다.
+func (s *Store) FetchBillConferenceList(params models.VCONFBILLCONFLISTRequestParams) (*models.VCONFBILLCONFLISTResponse, error) {
+	rows, total, err := find(s.billConferences, params)
+	if err != nil {
+		return nil, err
+	}
+	resp := &models.VCONFBILLCONFLISTResponse{}
+	if len(rows) > 0 {
+		resp.VCONFBILLCONFLIST = []models.VCONFBILLCONFLIST{
+			{Head: []models.VCONFBILLCONFLISTHead{{ListTotalCount: total}, {Result: models.VCONFBILLCONFLISTResult{Code: okCode, Message: okMessage}}}},
+			{Rows: rows},
+		}
+	}
+	return resp, nil
+}
+
+// FetchMeetingConferenceList는 저장된 본회의 회의록을 조회합니다. 최근 회의일자 순입니다.
+func (s *Store) FetchMeetingConferenceList(params models.VCONFPHCONFLISTRequestParams) (*models.VCONFPHCONFLISTResponse, error) {
+	rows, total, err := find(s.conferences, params)
+	if err != nil {
+		return nil, err
+	}
+	resp := &models.VCONFPHCONFLISTResponse{}
+	if len(rows) > 0 {
+		resp.VCONFPHCONFLIST = []models.VCONFPHCONFLIST{
+			{Head: []models.VCONFPHCONFLISTHead{{ListTotalCount: total}, {Result: models.VCONFPHCONFLISTResult{Code: okCode, Message: okMessage}}}},
+			{Rows: rows},
+		}
+	}
+	return resp, nil
+}
+
+// FetchMemberVoteResult는 저장된 표결 결과를 조회합니다.
+func (s *Store) FetchMemberVoteResult(params models.NojepdqqaweusdfbiRequestParams) (*models.NojepdqqaweusdfbiResponse, error) {
+	return s.FetchMemberVoteResultWithOptions(params, models.NojepdqqaweusdfbiOptionalParams{})
+}
+
+// FetchMemberVoteResultWithOptions는 선택 인자로 걸러 저장된 표결 결과를 조회합니다.
+func (s *Store) FetchMemberVoteResultWithOptions(params models.NojepdqqaweusdfbiRequestParams, optional models.NojepdqqaweusdfbiOptionalParams) (*models.NojepdqqaweusdfbiResponse, error) {
+	rows, total, err := find(s.votes, params, optional)
+	if err != nil {
+		return nil, err
+	}
+	resp := &models.NojepdqqaweusdfbiResponse{}
+	if len(rows) > 0 {
+		resp.Nojepdqqaweusdfbi = []models.Nojepdqqaweusdfbi{
+			{Head: []models.NojepdqqaweusdfbiHead{{ListTotalCount: total}, {Result: models.NojepdqqaweusdfbiResult{Code: okCode, Message: okMessage}}}},
+			{Rows: rows},
+		}
+	}
+	return resp, nil
+}
+
+// FetchMemberDetails는 저장된 의원 인적사항을 조회합니다. 이름 순입니다.
+func (s *Store) FetchMemberDetails(params models.NwvrqwxyaytdsfvhuRequestParams) (*models.NwvrqwxyaytdsfvhuResponse, error) {
+	return s.FetchMemberDetailsWithOptions(params, models.NwvrqwxyaytdsfvhuOptionalParams{})
+}
+
+// FetchMemberDetailsWithOptions는 선택 인자로 걸러 저장된 의원 인적사항을 조회합니다.
+func (s *Store) FetchMemberDetailsWithOptions(params models.NwvrqwxyaytdsfvhuRequestParams, optional models.NwvrqwxyaytdsfvhuOptionalParams) (*models.NwvrqwxyaytdsfvhuResponse, error) {
+	rows, total, err := find(s.members, params, optional)
+	if err != nil {
+		return nil, err
+	}
+	resp := &models.NwvrqwxyaytdsfvhuResponse{}
+	if len(rows) > 0 {
+		resp.Nwvrqwxyaytdsfvhu = []models.Nwvrqwxyaytdsfvhu{
+			{Head: []models.NwvrqwxyaytdsfvhuHead{{ListTotalCount: total}, {Result: models.NwvrqwxyaytdsfvhuResult{Code: okCode, Message: okMessage}}}},
+			{Rows: rows},
+		}
+	}
+	return resp, nil
+}
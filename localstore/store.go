@@ -0,0 +1,173 @@
+// Package localstore는 법안, 의원, 표결, 회의록을 디렉터리에 저장해 두고 오프라인으로 조회하는 저장소입니다.
+//
+// cgo나 외부 데이터베이스 없이 컬렉션마다 JSON Lines 파일 하나를 씁니다. Open할 때 파일을 모두 읽어 메모리에 올리고
+// BILL_ID, MONA_CD, AGE, CONF_ID와 날짜 필드에 색인을 만듭니다. Store는 assembly_go.Fetcher를 구현하므로
+// Client 대신 넘기면 같은 코드가 로컬 데이터로 동작합니다.
+//
+//	store, err := localstore.Open("data")
+//	defer store.Close()
+//	sink.Copy(store.Bills(), assembly_go.Paginate(1000, fetchBills)) // 온라인에서 한 번 채워 두고
+//	var f assembly_go.Fetcher = store                                 // 이후에는 오프라인으로 조회
+//
+// 한 디렉터리는 한 프로세스만 열어야 합니다.
+package localstore
+
+import (
+	"assembly_go"
+	"assembly_go/models"
+	"errors"
+	"os"
+)
+
+// Store는 컬렉션 다섯 개를 담은 로컬 저장소입니다. 여러 고루틴에서 동시에 사용해도 안전합니다.
+type Store struct {
+	dir             string
+	bills           *Collection[models.TVBPMBILL11Row]
+	members         *Collection[models.NwvrqwxyaytdsfvhuRow]
+	votes           *Collection[models.NojepdqqaweusdfbiRow]
+	conferences     *Collection[models.VCONFPHCONFLISTRow]
+	billConferences *Collection[models.VCONFBILLCONFLISTRow]
+}
+
+var _ assembly_go.Fetcher = (*Store)(nil)
+
+// Open은 dir의 저장소를 엽니다. 디렉터리나 컬렉션 파일이 없으면 새로 만듭니다.
+func Open(dir string) (*Store, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	s := &Store{dir: dir}
+	var err error
+	if s.bills, err = openCollection(dir, spec[models.TVBPMBILL11Row]{
+		name:     "bills",
+		key:      func(r models.TVBPMBILL11Row) string { return r.BillId },
+		keyField: "BILL_ID",
+		indexed:  []string{"AGE", "PROPOSE_DT", "PROC_DT", "RST_MONA_CD"},
+		order:    []Order{{Field: "PROPOSE_DT", Desc: true}},
+	}); err != nil {
+		return nil, err
+	}
+	if s.members, err = openCollection(dir, spec[models.NwvrqwxyaytdsfvhuRow]{
+		name:     "members",
+		key:      func(r models.NwvrqwxyaytdsfvhuRow) string { return r.MonaCd },
+		keyField: "MONA_CD",
+		indexed:  []string{"POLY_NM", "ORIG_NM"},
+		order:    []Order{{Field: "HG_NM"}},
+	}); err != nil {
+		return nil, s.closeOpened(err)
+	}
+	if s.votes, err = openCollection(dir, spec[models.NojepdqqaweusdfbiRow]{
+		name:    "votes",
+		key:     func(r models.NojepdqqaweusdfbiRow) string { return pairKey(r.BillID, r.MemberCode) },
+		indexed: []string{"BILL_ID", "MONA_CD", "AGE", "VOTE_DATE"},
+		order:   []Order{{Field: "VOTE_DATE", Desc: true}, {Field: "HG_NM"}},
+	}); err != nil {
+		return nil, s.closeOpened(err)
+	}
+	if s.conferences, err = openCollection(dir, spec[models.VCONFPHCONFLISTRow]{
+		name:     "conferences",
+		key:      func(r models.VCONFPHCONFLISTRow) string { return r.CONF_ID },
+		keyField: "CONF_ID",
+		indexed:  []string{"ERACO", "CONF_DT", "CMIT_CD"},
+		order:    []Order{{Field: "CONF_DT", Desc: true}},
+	}); err != nil {
+		return nil, s.closeOpened(err)
+	}
+	if s.billConferences, err = openCollection(dir, spec[models.VCONFBILLCONFLISTRow]{
+		name:    "bill_conferences",
+		key:     func(r models.VCONFBILLCONFLISTRow) string { return pairKey(r.BillId, r.ConferenceId) },
+		indexed: []string{"BILL_ID", "CONF_ID", "CONF_DT"},
+		order:   []Order{{Field: "CONF_DT"}},
+	}); err != nil {
+		return nil, s.closeOpened(err)
+	}
+	return s, nil
+}
+
+// pairKey는 두 필드로 이루어진 Key입니다. 둘 중 하나라도 비어있으면 빈 Key로 보고 Put이 거부합니다.
+func pairKey(a, b string) string {
+	if a == "" || b == "" {
+		return ""
+	}
+	return a + "/" + b
+}
+
+// Dir은 저장소 디렉터리를 반환합니다.
+func (s *Store) Dir() string {
+	return s.dir
+}
+
+// Bills는 법안 컬렉션입니다. Key는 BILL_ID입니다.
+func (s *Store) Bills() *Collection[models.TVBPMBILL11Row] {
+	return s.bills
+}
+
+// Members는 의원 인적사항 컬렉션입니다. Key는 MONA_CD입니다.
+func (s *Store) Members() *Collection[models.NwvrqwxyaytdsfvhuRow] {
+	return s.members
+}
+
+// Votes는 표결 컬렉션입니다. Key는 "BILL_ID/MONA_CD"입니다.
+func (s *Store) Votes() *Collection[models.NojepdqqaweusdfbiRow] {
+	return s.votes
+}
+
+// Conferences는 본회의 회의록 컬렉션입니다. Key는 CONF_ID입니다.
+func (s *Store) Conferences() *Collection[models.VCONFPHCONFLISTRow] {
+	return s.conferences
+}
+
+// BillConferences는 의안별 회의록 컬렉션입니다. Key는 "BILL_ID/CONF_ID"입니다.
+func (s *Store) BillConferences() *Collection[models.VCONFBILLCONFLISTRow] {
+	return s.billConferences
+}
+
+// compacter는 타입 매개변수와 상관없이 컬렉션을 다루기 위한 인터페이스입니다.
+type compacter interface {
+	compact() error
+	close() error
+}
+
+func (s *Store) collections() []compacter {
+	var all []compacter
+	if s.bills != nil {
+		all = append(all, s.bills)
+	}
+	if s.members != nil {
+		all = append(all, s.members)
+	}
+	if s.votes != nil {
+		all = append(all, s.votes)
+	}
+	if s.conferences != nil {
+		all = append(all, s.conferences)
+	}
+	if s.billConferences != nil {
+		all = append(all, s.billConferences)
+	}
+	return all
+}
+
+// Compact는 각 컬렉션 파일을 현재 행만 남기도록 다시 씁니다. 변경이 쌓여 파일이 커졌을 때 호출합니다.
+func (s *Store) Compact() error {
+	var errs []error
+	for _, c := range s.collections() {
+		errs = append(errs, c.compact())
+	}
+	return errors.Join(errs...)
+}
+
+// Close는 버퍼에 남은 변경을 쓰고 파일을 닫습니다.
+func (s *Store) Close() error {
+	var errs []error
+	for _, c := range s.collections() {
+		errs = append(errs, c.close())
+	}
+	return errors.Join(errs...)
+}
+
+// closeOpened는 Open 도중 실패했을 때 이미 연 컬렉션을 닫고 err를 반환합니다.
+func (s *Store) closeOpened(err error) error {
+	s.Close()
+	return err
+}
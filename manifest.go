@@ -0,0 +1,167 @@
+package assembly_go
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ManifestEntry는 매니페스트에 기록된 다운로드 결과 하나입니다.
+// Error가 비어있으면 성공이며, Path의 파일은 SHA256과 같은 내용을 가집니다.
+type ManifestEntry struct {
+	Key         string    `json:"key"`                    // 작업 식별자 (DownloadJob.Key)
+	BillID      string    `json:"bill_id,omitempty"`      // 법안 PDF의 의안 식별자
+	URL         string    `json:"url,omitempty"`          // 회의록 등 PDF URL
+	Path        string    `json:"path,omitempty"`         // 저장 디렉터리 기준 파일 경로 (슬래시 구분)
+	Size        int64     `json:"size,omitempty"`         // 파일 크기 (바이트)
+	SHA256      string    `json:"sha256,omitempty"`       // 내용의 SHA-256 (16진수)
+	ContentType string    `json:"content_type,omitempty"` // 내용으로 판별한 MIME 타입
+	DuplicateOf string    `json:"duplicate_of,omitempty"` // 내용이 같은 파일을 먼저 받은 작업의 Key. 이 경우 Path는 그 파일을 가리킵니다.
+	FetchedAt   time.Time `json:"fetched_at"`             // 다운로드를 마친 시각
+	Error       string    `json:"error,omitempty"`        // 실패한 경우 에러 메시지
+}
+
+// OK는 다운로드에 성공한 항목인지 확인합니다.
+func (e ManifestEntry) OK() bool {
+	return e.Error == "" && e.Path != ""
+}
+
+// Manifest는 일괄 다운로드 결과를 Key별로 기록하는 JSON 파일입니다.
+// BulkDownloader가 중단된 뒤 다시 실행되면 이 기록으로 이미 받은 파일을 건너뜁니다.
+type Manifest struct {
+	path    string
+	mu      sync.RWMutex
+	entries map[string]ManifestEntry
+	bySHA   map[string]string // SHA-256 → 그 내용을 처음 저장한 기록의 Key
+	saveMu  sync.Mutex        // 파일 쓰기 직렬화
+}
+
+// LoadManifest는 path의 매니페스트를 읽습니다. 파일이 없으면 비어있는 매니페스트를 반환하며, 첫 Save 때 파일이 만들어집니다.
+func LoadManifest(path string) (*Manifest, error) {
+	m := &Manifest{path: path, entries: make(map[string]ManifestEntry), bySHA: make(map[string]string)}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return m, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+	if len(data) == 0 {
+		return m, nil
+	}
+
+	var entries []ManifestEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest %s: %w", path, err)
+	}
+	for _, e := range entries {
+		m.put(e)
+	}
+	return m, nil
+}
+
+// Path는 매니페스트 파일 경로를 반환합니다.
+func (m *Manifest) Path() string {
+	return m.path
+}
+
+// Get은 key에 대한 기록을 반환합니다.
+func (m *Manifest) Get(key string) (ManifestEntry, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	e, ok := m.entries[key]
+	return e, ok
+}
+
+// Entries는 모든 기록을 Key 순으로 반환합니다.
+func (m *Manifest) Entries() []ManifestEntry {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	entries := make([]ManifestEntry, 0, len(m.entries))
+	for _, e := range m.entries {
+		entries = append(entries, e)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Key < entries[j].Key })
+	return entries
+}
+
+// Failures는 실패한 기록만 Key 순으로 반환합니다.
+func (m *Manifest) Failures() []ManifestEntry {
+	var failures []ManifestEntry
+	for _, e := range m.Entries() {
+		if e.Error != "" {
+			failures = append(failures, e)
+		}
+	}
+	return failures
+}
+
+// Len은 기록 수를 반환합니다.
+func (m *Manifest) Len() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.entries)
+}
+
+// Put은 기록을 추가하거나 같은 Key의 기록을 바꿉니다. 파일에는 Save를 호출해야 반영됩니다.
+func (m *Manifest) Put(e ManifestEntry) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.put(e)
+}
+
+func (m *Manifest) put(e ManifestEntry) {
+	m.entries[e.Key] = e
+	if e.OK() && e.DuplicateOf == "" && e.SHA256 != "" {
+		// 원본이 없거나, 원본 기록이 다시 받아져 내용이 바뀐 경우에만 이 기록을 원본으로 삼습니다.
+		if cur, ok := m.bySHA[e.SHA256]; !ok || !m.entries[cur].OK() || m.entries[cur].SHA256 != e.SHA256 {
+			m.bySHA[e.SHA256] = e.Key
+		}
+	}
+}
+
+// findSHA256은 sum과 내용이 같은 성공 기록 중 원본(DuplicateOf가 비어있는 것)을 찾습니다.
+func (m *Manifest) findSHA256(sum string) (ManifestEntry, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	key, ok := m.bySHA[sum]
+	if !ok {
+		return ManifestEntry{}, false
+	}
+	e := m.entries[key]
+	if !e.OK() || e.SHA256 != sum {
+		return ManifestEntry{}, false
+	}
+	return e, true
+}
+
+// Save는 기록 전체를 임시 파일에 쓴 뒤 이름을 바꿔 반영합니다. 저장 도중 중단되어도 이전 매니페스트는 그대로 남습니다.
+func (m *Manifest) Save() error {
+	m.saveMu.Lock()
+	defer m.saveMu.Unlock()
+
+	data, err := json.MarshalIndent(m.Entries(), "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode manifest: %w", err)
+	}
+
+	if dir := filepath.Dir(m.path); dir != "" {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return fmt.Errorf("failed to create manifest directory: %w", err)
+		}
+	}
+	tmp := m.path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("failed to write manifest: %w", err)
+	}
+	if err := os.Rename(tmp, m.path); err != nil {
+		return fmt.Errorf("failed to write manifest: %w", err)
+	}
+	return nil
+}
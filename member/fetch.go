@@ -0,0 +1,65 @@
+package member
+
+import (
+	"assembly_go"
+	"assembly_go/models"
+	"strconv"
+)
+
+// fetchPageSize는 Fetch가 한 번에 요청하는 행 수입니다.
+const fetchPageSize = 1000
+
+// Fetch는 client로 세 출처를 모두 조회해 추가합니다. daesu를 주면 그 대수의 역대 국회의원 현황도 조회합니다.
+// 역대 현황은 DAESU가 필수라 daesu가 없으면 조회하지 않습니다.
+func (r *Resolver) Fetch(client *assembly_go.Client, daesu ...string) error {
+	for row, err := range assembly_go.Paginate(fetchPageSize, func(pIndex, pSize int) ([]models.NwvrqwxyaytdsfvhuRow, int, error) {
+		resp, err := client.FetchMemberDetails(models.NwvrqwxyaytdsfvhuRequestParams{Pindex: strconv.Itoa(pIndex), Psize: strconv.Itoa(pSize)})
+		if err == nil {
+			err = assembly_go.CheckResult(resp)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.AllRows(), resp.TotalCount(), nil
+	}) {
+		if err != nil {
+			return err
+		}
+		r.AddMemberDetails(row)
+	}
+
+	for row, err := range assembly_go.Paginate(fetchPageSize, func(pIndex, pSize int) ([]models.AllNameMemberRow, int, error) {
+		resp, err := client.FetchAllMembers(models.AllNameMemberRequestParams{Pindex: strconv.Itoa(pIndex), Psize: strconv.Itoa(pSize)})
+		if err == nil {
+			err = assembly_go.CheckResult(resp)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.AllRows(), resp.TotalCount(), nil
+	}) {
+		if err != nil {
+			return err
+		}
+		r.AddAllNameMembers(row)
+	}
+
+	for _, d := range daesu {
+		for row, err := range assembly_go.Paginate(fetchPageSize, func(pIndex, pSize int) ([]models.NprlapfmaufmqytetRow, int, error) {
+			resp, err := client.FetchHistoricalMembers(models.NprlapfmaufmqytetRequestParams{Pindex: strconv.Itoa(pIndex), Psize: strconv.Itoa(pSize), DAESU: d})
+			if err == nil {
+				err = assembly_go.CheckResult(resp)
+			}
+			if err != nil {
+				return nil, 0, err
+			}
+			return resp.AllRows(), resp.TotalCount(), nil
+		}) {
+			if err != nil {
+				return err
+			}
+			r.AddHistorical(row)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,456 @@
+// Package member는 여러 OpenAPI에 나뉘어 있는 국회의원 정보를 하나의 Member로 합칩니다.
+//
+// 국회의원 인적사항(nwvrqwxyaytdsfvhu, MONA_CD)과 국회의원 정보 통합(ALLNAMEMBER, NAAS_CD)은 같은 의원 코드로 합치고,
+// 코드가 없는 역대 국회의원 현황(nprlapfmaufmqytet)은 이름, 한자 이름, 생년월일로 찾아 합칩니다.
+// 같은 항목의 값이 출처마다 다르면 Conflict로 알려줍니다.
+//
+//	r := member.NewResolver()
+//	r.AddMemberDetails(details...)
+//	r.AddAllNameMembers(all...)
+//	r.AddHistorical(historical...)
+//	result := r.Resolve()
+package member
+
+import (
+	"assembly_go/models"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Source는 값을 가져온 OpenAPI입니다. 같은 항목의 값이 여럿이면 아래 순서가 앞선 출처의 값을 씁니다.
+type Source string
+
+const (
+	SourceDetails    Source = "nwvrqwxyaytdsfvhu" // 국회의원 인적사항 (현직)
+	SourceAllName    Source = "ALLNAMEMBER"       // 국회의원 정보 통합
+	SourceHistorical Source = "nprlapfmaufmqytet" // 역대 국회의원 현황
+)
+
+var sourceRank = map[Source]int{SourceDetails: 0, SourceAllName: 1, SourceHistorical: 2}
+
+// Term은 역대 국회의원 현황의 한 대수 기록입니다.
+type Term struct {
+	Age         string `json:"age"`         // 대수 (DAESU)
+	Affiliation string `json:"affiliation"` // 대별 및 소속정당 (DAE)
+}
+
+// Member는 출처를 합친 국회의원 한 명입니다. 비어있는 항목은 어느 출처에도 값이 없었다는 뜻입니다.
+type Member struct {
+	Code           string `json:"code,omitempty"` // 국회의원 코드 (MONA_CD, NAAS_CD). 역대 현황에만 있는 의원은 비어있습니다.
+	Name           string `json:"name"`
+	NameHanja      string `json:"name_hanja,omitempty"`
+	NameEnglish    string `json:"name_english,omitempty"`
+	BirthDate      string `json:"birth_date,omitempty"` // 8자리 숫자면 "2006-01-02" 형식으로 바꿉니다.
+	BirthCalendar  string `json:"birth_calendar,omitempty"`
+	Gender         string `json:"gender,omitempty"`
+	Party          string `json:"party,omitempty"`
+	District       string `json:"district,omitempty"`
+	ElectionType   string `json:"election_type,omitempty"`
+	Committee      string `json:"committee,omitempty"`
+	Committees     string `json:"committees,omitempty"`
+	Reelection     string `json:"reelection,omitempty"`
+	Units          string `json:"units,omitempty"`
+	Job            string `json:"job,omitempty"`
+	Phone          string `json:"phone,omitempty"`
+	Email          string `json:"email,omitempty"`
+	Homepage       string `json:"homepage,omitempty"`
+	Office         string `json:"office,omitempty"`
+	Career         string `json:"career,omitempty"`
+	Photo          string `json:"photo,omitempty"`
+	Aide           string `json:"aide,omitempty"`
+	ChiefSecretary string `json:"chief_secretary,omitempty"`
+	Secretary      string `json:"secretary,omitempty"`
+
+	Terms   []Term   `json:"terms,omitempty"`
+	Sources []Source `json:"sources"`
+}
+
+// SourceValue는 한 출처가 가진 값입니다.
+type SourceValue struct {
+	Source Source `json:"source"`
+	Value  string `json:"value"`
+}
+
+// Conflict는 한 의원의 같은 항목에 출처마다 다른 값이 있다는 보고입니다. Member에는 Values[0]이 쓰였습니다.
+type Conflict struct {
+	Code   string        `json:"code,omitempty"`
+	Name   string        `json:"name"`
+	Field  string        `json:"field"` // Member의 JSON 필드 이름
+	Values []SourceValue `json:"values"`
+}
+
+// Result는 Resolve의 결과입니다.
+type Result struct {
+	Members   []Member   // 이름, 코드 순
+	Conflicts []Conflict // 이름, 코드, 항목 순
+	byCode    map[string]int
+}
+
+// Get은 코드로 의원을 찾습니다.
+func (r *Result) Get(code string) (Member, bool) {
+	i, ok := r.byCode[code]
+	if !ok {
+		return Member{}, false
+	}
+	return r.Members[i], true
+}
+
+// field는 Member의 한 항목과 출처를 비교할 때 쓰는 정규화 함수입니다.
+type field struct {
+	name string
+	ptr  func(*Member) *string
+	norm func(string) string
+}
+
+var fields = []field{
+	{"name", func(m *Member) *string { return &m.Name }, compact},
+	{"name_hanja", func(m *Member) *string { return &m.NameHanja }, compact},
+	{"name_english", func(m *Member) *string { return &m.NameEnglish }, upperCompact},
+	{"birth_date", func(m *Member) *string { return &m.BirthDate }, digits},
+	{"birth_calendar", func(m *Member) *string { return &m.BirthCalendar }, compact},
+	{"gender", func(m *Member) *string { return &m.Gender }, compact},
+	{"party", func(m *Member) *string { return &m.Party }, compact},
+	{"district", func(m *Member) *string { return &m.District }, compact},
+	{"election_type", func(m *Member) *string { return &m.ElectionType }, compact},
+	{"committee", func(m *Member) *string { return &m.Committee }, compact},
+	{"committees", func(m *Member) *string { return &m.Committees }, sortedList},
+	{"reelection", func(m *Member) *string { return &m.Reelection }, compact},
+	{"units", func(m *Member) *string { return &m.Units }, sortedList},
+	{"job", func(m *Member) *string { return &m.Job }, compact},
+	{"phone", func(m *Member) *string { return &m.Phone }, digits},
+	{"email", func(m *Member) *string { return &m.Email }, lowerCompact},
+	{"homepage", func(m *Member) *string { return &m.Homepage }, homepageKey},
+	{"office", func(m *Member) *string { return &m.Office }, compact},
+	{"career", func(m *Member) *string { return &m.Career }, compact},
+	{"photo", func(m *Member) *string { return &m.Photo }, compact},
+	{"aide", func(m *Member) *string { return &m.Aide }, sortedList},
+	{"chief_secretary", func(m *Member) *string { return &m.ChiefSecretary }, sortedList},
+	{"secretary", func(m *Member) *string { return &m.Secretary }, sortedList},
+}
+
+// observation은 출처 하나가 준 항목별 값입니다.
+type observation struct {
+	source Source
+	values map[string]string
+}
+
+// builder는 한 의원에 대해 모은 관측값입니다.
+type builder struct {
+	code         string
+	observations []observation
+	terms        []Term
+}
+
+func (b *builder) identity() (name, hanja, birth string) {
+	for _, o := range b.sorted() {
+		if name == "" {
+			name = compact(o.values["name"])
+		}
+		if hanja == "" {
+			hanja = compact(o.values["name_hanja"])
+		}
+		if birth == "" {
+			birth = digits(o.values["birth_date"])
+		}
+	}
+	return name, hanja, birth
+}
+
+func (b *builder) sorted() []observation {
+	obs := slices.Clone(b.observations)
+	sort.SliceStable(obs, func(i, j int) bool { return sourceRank[obs[i].source] < sourceRank[obs[j].source] })
+	return obs
+}
+
+// build는 출처 우선순위에 따라 값을 골라 Member를 만들고, 서로 다른 값이 있는 항목을 Conflict로 반환합니다.
+func (b *builder) build() (Member, []Conflict) {
+	m := Member{Code: b.code, Terms: b.terms}
+	obs := b.sorted()
+	for _, o := range obs {
+		if !slices.Contains(m.Sources, o.source) {
+			m.Sources = append(m.Sources, o.source)
+		}
+	}
+
+	var conflicts []Conflict
+	for _, f := range fields {
+		var values []SourceValue
+		seen := make(map[string]bool)
+		for _, o := range obs {
+			v := strings.TrimSpace(o.values[f.name])
+			if v == "" {
+				continue
+			}
+			if len(values) == 0 {
+				*f.ptr(&m) = v
+			}
+			if key := f.norm(v); !seen[key] {
+				seen[key] = true
+				values = append(values, SourceValue{Source: o.source, Value: v})
+			}
+		}
+		if len(values) > 1 {
+			conflicts = append(conflicts, Conflict{Code: b.code, Field: f.name, Values: values})
+		}
+	}
+	m.BirthDate = formatDate(m.BirthDate)
+	for i := range conflicts {
+		conflicts[i].Name = m.Name
+	}
+	return m, conflicts
+}
+
+// Resolver는 세 출처의 행을 모아 Resolve로 합칩니다. 행을 추가하는 순서는 결과에 영향을 주지 않습니다.
+type Resolver struct {
+	details    []models.NwvrqwxyaytdsfvhuRow
+	allName    []models.AllNameMemberRow
+	historical []models.NprlapfmaufmqytetRow
+}
+
+// NewResolver는 빈 Resolver를 생성합니다.
+func NewResolver() *Resolver {
+	return &Resolver{}
+}
+
+// AddMemberDetails는 국회의원 인적사항 행을 추가합니다.
+func (r *Resolver) AddMemberDetails(rows ...models.NwvrqwxyaytdsfvhuRow) {
+	r.details = append(r.details, rows...)
+}
+
+// AddAllNameMembers는 국회의원 정보 통합 행을 추가합니다.
+func (r *Resolver) AddAllNameMembers(rows ...models.AllNameMemberRow) {
+	r.allName = append(r.allName, rows...)
+}
+
+// AddHistorical은 역대 국회의원 현황 행을 추가합니다.
+func (r *Resolver) AddHistorical(rows ...models.NprlapfmaufmqytetRow) {
+	r.historical = append(r.historical, rows...)
+}
+
+// Resolve는 모은 행을 의원별로 합칩니다.
+//
+// 코드가 있는 두 출처는 코드로 합칩니다. 역대 현황 행은 이름, 한자 이름, 생년월일이 모두 같은 의원에 합치고,
+// 한자 이름이 한쪽에 없으면 이름과 생년월일이 같은 의원이 하나뿐일 때 합칩니다. 찾지 못한 역대 현황 행은
+// 같은 사람끼리 묶어 코드 없는 Member가 됩니다.
+func (r *Resolver) Resolve() *Result {
+	byCode := make(map[string]*builder)
+	var builders []*builder
+	coded := func(code string) *builder {
+		b, ok := byCode[code]
+		if !ok {
+			b = &builder{code: code}
+			byCode[code] = b
+			builders = append(builders, b)
+		}
+		return b
+	}
+	for _, row := range r.details {
+		if code := strings.TrimSpace(row.MonaCd); code != "" {
+			b := coded(code)
+			b.observations = append(b.observations, observation{SourceDetails, detailsValues(row)})
+		}
+	}
+	for _, row := range r.allName {
+		if code := strings.TrimSpace(row.NaasCd); code != "" {
+			b := coded(code)
+			b.observations = append(b.observations, observation{SourceAllName, allNameValues(row)})
+		}
+	}
+
+	exact := make(map[string]*builder)
+	byNameBirth := make(map[string][]*builder)
+	for _, b := range builders {
+		name, hanja, birth := b.identity()
+		if name == "" || birth == "" {
+			continue
+		}
+		exact[name+"|"+hanja+"|"+birth] = b
+		byNameBirth[name+"|"+birth] = append(byNameBirth[name+"|"+birth], b)
+	}
+
+	for _, row := range r.historical {
+		values := historicalValues(row)
+		name, hanja, birth := compact(values["name"]), compact(values["name_hanja"]), digits(values["birth_date"])
+		b := exact[name+"|"+hanja+"|"+birth]
+		if b == nil {
+			candidates := byNameBirth[name+"|"+birth]
+			var matches []*builder
+			for _, c := range candidates {
+				if _, h, _ := c.identity(); h == "" || hanja == "" || h == hanja {
+					matches = append(matches, c)
+				}
+			}
+			if len(matches) == 1 {
+				b = matches[0]
+			}
+		}
+		if b == nil {
+			// 코드가 있는 의원과 맞지 않으면 역대 현황 행끼리 묶습니다.
+			b = &builder{}
+			builders = append(builders, b)
+			exact[name+"|"+hanja+"|"+birth] = b
+			byNameBirth[name+"|"+birth] = append(byNameBirth[name+"|"+birth], b)
+		}
+		b.observations = append(b.observations, observation{SourceHistorical, values})
+		if age := strings.TrimSpace(row.DaeSu); age != "" || row.Dae != "" {
+			b.terms = append(b.terms, Term{Age: age, Affiliation: strings.TrimSpace(row.Dae)})
+		}
+	}
+
+	result := &Result{byCode: make(map[string]int)}
+	for _, b := range builders {
+		sort.SliceStable(b.terms, func(i, j int) bool { return compareAge(b.terms[i].Age, b.terms[j].Age) < 0 })
+		m, conflicts := b.build()
+		result.Members = append(result.Members, m)
+		result.Conflicts = append(result.Conflicts, conflicts...)
+	}
+	sort.SliceStable(result.Members, func(i, j int) bool {
+		a, b := result.Members[i], result.Members[j]
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.Code < b.Code
+	})
+	sort.SliceStable(result.Conflicts, func(i, j int) bool {
+		a, b := result.Conflicts[i], result.Conflicts[j]
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.Code < b.Code
+	})
+	for i, m := range result.Members {
+		if m.Code != "" {
+			result.byCode[m.Code] = i
+		}
+	}
+	return result
+}
+
+func detailsValues(r models.NwvrqwxyaytdsfvhuRow) map[string]string {
+	return map[string]string{
+		"name":            r.HgNm,
+		"name_hanja":      deref(r.HjNm),
+		"name_english":    deref(r.EngNm),
+		"birth_date":      deref(r.BthDate),
+		"birth_calendar":  deref(r.BthGbnNm),
+		"gender":          deref(r.SexGbnNm),
+		"party":           deref(r.PolyNm),
+		"district":        deref(r.OrigNm),
+		"election_type":   deref(r.ElectGbnNm),
+		"committee":       deref(r.CmitNm),
+		"committees":      deref(r.Cmits),
+		"reelection":      deref(r.ReeleGbnNm),
+		"units":           deref(r.Units),
+		"job":             deref(r.JobResNm),
+		"phone":           deref(r.TelNo),
+		"email":           deref(r.EMail),
+		"homepage":        deref(r.Homepage),
+		"office":          deref(r.AssemAddr),
+		"career":          deref(r.MemTitle),
+		"aide":            deref(r.Staff),
+		"chief_secretary": deref(r.Secretary),
+		"secretary":       deref(r.Secretary2),
+	}
+}
+
+// allNameValues는 ALLNAMEMBER 행의 값입니다. BIRDY_DIV_CD는 이름이 아닌 코드라 비교하지 않습니다.
+func allNameValues(r models.AllNameMemberRow) map[string]string {
+	return map[string]string{
+		"name":            r.NaasNm,
+		"name_hanja":      r.NaasChNm,
+		"name_english":    r.NaasEnNm,
+		"birth_date":      r.BirdyDt,
+		"party":           r.PlptNm,
+		"district":        r.ElecdNm,
+		"election_type":   r.ElecdDivNm,
+		"committee":       r.CmitNm,
+		"committees":      r.BlngCmitNm,
+		"reelection":      r.RlctDivNm,
+		"units":           r.GteltEraco,
+		"job":             r.DtyNm,
+		"phone":           r.NaasTelNo,
+		"email":           r.NaasEmailAddr,
+		"homepage":        r.NaasHpUrl,
+		"office":          r.OffmRnumNo,
+		"career":          r.BrfHst,
+		"photo":           r.NaasPic,
+		"aide":            r.AideNm,
+		"chief_secretary": r.ChfScrtNm,
+		"secretary":       r.ScrtNm,
+	}
+}
+
+// historicalValues는 역대 현황 행의 값입니다. 소속정당은 대수마다 달라 Term에만 남깁니다.
+func historicalValues(r models.NprlapfmaufmqytetRow) map[string]string {
+	return map[string]string{
+		"name":       r.Name,
+		"name_hanja": r.NameHan,
+		"birth_date": r.Birth,
+	}
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// compact는 공백을 모두 지웁니다. 출처마다 띄어쓰기가 다른 값을 같은 값으로 보기 위해서입니다.
+func compact(s string) string {
+	return strings.Join(strings.Fields(s), "")
+}
+
+func upperCompact(s string) string {
+	return strings.ToUpper(compact(s))
+}
+
+func lowerCompact(s string) string {
+	return strings.ToLower(compact(s))
+}
+
+// digits는 숫자만 남깁니다. 날짜와 전화번호의 구분자 차이를 무시합니다.
+func digits(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, s)
+}
+
+// homepageKey는 스킴과 끝의 슬래시 차이를 무시합니다.
+func homepageKey(s string) string {
+	s = lowerCompact(s)
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "https://"), "http://")
+	return strings.TrimSuffix(s, "/")
+}
+
+// sortedList는 쉼표로 구분된 목록을 순서와 공백에 상관없이 비교합니다.
+func sortedList(s string) string {
+	items := strings.Split(s, ",")
+	for i, item := range items {
+		items[i] = compact(item)
+	}
+	slices.Sort(items)
+	return strings.Join(slices.Compact(items), ",")
+}
+
+// formatDate는 숫자 8자리 날짜를 "2006-01-02" 형식으로 바꾸고, 그 밖의 값은 그대로 둡니다.
+func formatDate(s string) string {
+	d := digits(s)
+	if len(d) != 8 {
+		return s
+	}
+	return d[:4] + "-" + d[4:6] + "-" + d[6:]
+}
+
+// compareAge는 "21", "제21대"처럼 숫자가 들어있는 대수를 숫자로 비교합니다.
+func compareAge(a, b string) int {
+	x, y := digits(a), digits(b)
+	if len(x) != len(y) {
+		return len(x) - len(y)
+	}
+	return strings.Compare(x, y)
+}
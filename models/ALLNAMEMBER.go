@@ -19,6 +19,7 @@ type AllNameMemberOptionalParams struct {
 // API 응답 구조체
 type AllNameMemberResponse struct {
 	AllNameMember []AllNameMember `json:"ALLNAMEMBER"`
+	Status        *Result         `json:"RESULT,omitempty"` // 오류 응답의 RESULT
 }
 
 type AllNameMember struct {
@@ -45,7 +46,7 @@ type AllNameMemberRow struct {
 	BirdyDivCd    string `json:"BIRDY_DIV_CD"`    // 생년월일 구분코드
 	BirdyDt       string `json:"BIRDY_DT"`        // 생년월일
 	DtyNm         string `json:"DTY_NM"`          // 직업명
-	PlptNm        string `json:"PLPT_NM"`         // 배우자명
+	PlptNm        string `json:"PLPT_NM"`         // 정당명
 	ElecdNm       string `json:"ELECD_NM"`        // 선거구명
 	ElecdDivNm    string `json:"ELECD_DIV_NM"`    // 선거구 구분명
 	CmitNm        string `json:"CMIT_NM"`         // 위원회명
@@ -63,3 +64,18 @@ type AllNameMemberRow struct {
 	OffmRnumNo    string `json:"OFFM_RNUM_NO"`    // 국회사무실 연락번호
 	NaasPic       string `json:"NAAS_PIC"`        // 사진 정보
 }
+
+// AllRows는 모든 구역의 의원 인적사항 행을 순서대로 모아 반환합니다.
+func (r *AllNameMemberResponse) AllRows() []AllNameMemberRow {
+	return allRows(r.AllNameMember, func(s AllNameMember) []AllNameMemberRow { return s.Rows })
+}
+
+// TotalCount는 조건에 맞는 의원 인적사항 전체 행 수입니다.
+func (r *AllNameMemberResponse) TotalCount() int {
+	return totalCount(r.AllNameMember, func(s AllNameMember) []AllNameMemberHead { return s.Head }, func(h AllNameMemberHead) int { return h.ListTotalCount })
+}
+
+// Result는 의원 인적사항 응답의 처리 결과입니다.
+func (r *AllNameMemberResponse) Result() Result {
+	return result(r.Status, r.AllNameMember, func(s AllNameMember) []AllNameMemberHead { return s.Head }, func(h AllNameMemberHead) Result { return Result(h.Result) })
+}
@@ -23,6 +23,7 @@ type TVBPMBILL11OptionalParams struct {
 // 법률안 심사 및 처리(의안검색)
 type TVBPMBILL11Response struct {
 	TVBPMBILL11 []TVBPMBILL11 `json:"TVBPMBILL11"`
+	Status      *Result       `json:"RESULT,omitempty"` // 오류 응답의 RESULT
 }
 
 type TVBPMBILL11 struct {
@@ -66,3 +67,18 @@ type TVBPMBILL11Row struct {
 	PlenarySessionReviewResult           string  `json:"PROC_RESULT_CD"`     // 본회의 심의 결과
 	ResolutionDate                       string  `json:"PROC_DT"`            // 의결일
 }
+
+// AllRows는 모든 구역의 의안 행을 순서대로 모아 반환합니다.
+func (r *TVBPMBILL11Response) AllRows() []TVBPMBILL11Row {
+	return allRows(r.TVBPMBILL11, func(s TVBPMBILL11) []TVBPMBILL11Row { return s.Rows })
+}
+
+// TotalCount는 조건에 맞는 의안 전체 행 수입니다.
+func (r *TVBPMBILL11Response) TotalCount() int {
+	return totalCount(r.TVBPMBILL11, func(s TVBPMBILL11) []TVBPMBILL11Head { return s.Head }, func(h TVBPMBILL11Head) int { return h.ListTotalCount })
+}
+
+// Result는 의안 응답의 처리 결과입니다.
+func (r *TVBPMBILL11Response) Result() Result {
+	return result(r.Status, r.TVBPMBILL11, func(s TVBPMBILL11) []TVBPMBILL11Head { return s.Head }, func(h TVBPMBILL11Head) Result { return Result(h.Result) })
+}
@@ -2,15 +2,21 @@
 package models
 
 type VCONFBILLCONFLISTRequestParams struct {
-	Key     string `json:"KEY" validate:"required"`    // 인증키 (필수)
-	Type    string `json:"Type" validate:"required"`   // 호출 문서 타입 (xml, json) (필수)
-	Pindex  int    `json:"pIndex" validate:"required"` // 페이지 위치 (필수)
-	Psize   int    `json:"pSize" validate:"required"`  // 페이지 당 요청 숫자 (필수)
-	BILL_ID string `json:BILL_ID validate:"required"`  // 의안ID
+	Key     string `json:"KEY" validate:"required"`     // 인증키 (필수)
+	Type    string `json:"Type" validate:"required"`    // 호출 문서 타입 (xml, json) (필수)
+	Pindex  int    `json:"pIndex" validate:"required"`  // 페이지 위치 (필수)
+	Psize   int    `json:"pSize" validate:"required"`   // 페이지 당 요청 숫자 (필수)
+	BILL_ID string `json:"BILL_ID" validate:"required"` // 의안ID
+}
+
+type VCONFBILLCONFLISTOptionalParams struct {
+	CONF_KND *string `json:"CONF_KND,omitempty"` // 회의록 종류
+	CONF_DT  *string `json:"CONF_DT,omitempty"`  // 회의 날짜
 }
 
 type VCONFBILLCONFLISTResponse struct {
 	VCONFBILLCONFLIST []VCONFBILLCONFLIST `json:"VCONFBILLCONFLIST"`
+	Status            *Result             `json:"RESULT,omitempty"` // 오류 응답의 RESULT
 }
 
 type VCONFBILLCONFLIST struct {
@@ -39,3 +45,18 @@ type VCONFBILLCONFLISTRow struct {
 	ConferenceDate string `json:"CONF_DT"`  // 회의 날짜
 	DownloadUrl    string `json:"DOWN_URL"` // 다운로드 URL
 }
+
+// AllRows는 모든 구역의 의안별 회의록 행을 순서대로 모아 반환합니다.
+func (r *VCONFBILLCONFLISTResponse) AllRows() []VCONFBILLCONFLISTRow {
+	return allRows(r.VCONFBILLCONFLIST, func(s VCONFBILLCONFLIST) []VCONFBILLCONFLISTRow { return s.Rows })
+}
+
+// TotalCount는 조건에 맞는 의안별 회의록 전체 행 수입니다.
+func (r *VCONFBILLCONFLISTResponse) TotalCount() int {
+	return totalCount(r.VCONFBILLCONFLIST, func(s VCONFBILLCONFLIST) []VCONFBILLCONFLISTHead { return s.Head }, func(h VCONFBILLCONFLISTHead) int { return h.ListTotalCount })
+}
+
+// Result는 의안별 회의록 응답의 처리 결과입니다.
+func (r *VCONFBILLCONFLISTResponse) Result() Result {
+	return result(r.Status, r.VCONFBILLCONFLIST, func(s VCONFBILLCONFLIST) []VCONFBILLCONFLISTHead { return s.Head }, func(h VCONFBILLCONFLISTHead) Result { return Result(h.Result) })
+}
@@ -10,9 +10,17 @@ type VCONFPHCONFLISTRequestParams struct {
 	ERACO  string `json:"ERACO" validate:"required"`  // 대수 (필수)
 }
 
+// VCONFPHCONFLISTOptionalParams represents optional search parameters for the VCONFPHCONFLIST API.
+type VCONFPHCONFLISTOptionalParams struct {
+	SESS    *string `json:"SESS,omitempty"`    // 회기
+	DGR     *string `json:"DGR,omitempty"`     // 차수
+	CONF_DT *string `json:"CONF_DT,omitempty"` // 회의일자
+}
+
 // VCONFPHCONFLISTResponse represents the response from the VCONFPHCONFLIST API.
 type VCONFPHCONFLISTResponse struct {
 	VCONFPHCONFLIST []VCONFPHCONFLIST `json:"VCONFPHCONFLIST"`
+	Status          *Result           `json:"RESULT,omitempty"` // 오류 응답의 RESULT
 }
 
 // VCONFPHCONFLIST represents a single entry in the VCONFPHCONFLIST API response.
@@ -45,3 +53,18 @@ type VCONFPHCONFLISTRow struct {
 	CMIT_NM  string `json:"CMIT_NM"`  // 위원회명
 	DOWN_URL string `json:"DOWN_URL"` // 다운로드 URL
 }
+
+// AllRows는 모든 구역의 회의록 행을 순서대로 모아 반환합니다.
+func (r *VCONFPHCONFLISTResponse) AllRows() []VCONFPHCONFLISTRow {
+	return allRows(r.VCONFPHCONFLIST, func(s VCONFPHCONFLIST) []VCONFPHCONFLISTRow { return s.Rows })
+}
+
+// TotalCount는 조건에 맞는 회의록 전체 행 수입니다.
+func (r *VCONFPHCONFLISTResponse) TotalCount() int {
+	return totalCount(r.VCONFPHCONFLIST, func(s VCONFPHCONFLIST) []VCONFPHCONFLISTHead { return s.Head }, func(h VCONFPHCONFLISTHead) int { return h.ListTotalCount })
+}
+
+// Result는 회의록 응답의 처리 결과입니다.
+func (r *VCONFPHCONFLISTResponse) Result() Result {
+	return result(r.Status, r.VCONFPHCONFLIST, func(s VCONFPHCONFLIST) []VCONFPHCONFLISTHead { return s.Head }, func(h VCONFPHCONFLISTHead) Result { return Result(h.Result) })
+}
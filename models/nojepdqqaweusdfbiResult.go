@@ -1,15 +1,18 @@
 // 국회의원 본회의 표결정보
 package models
 
-import "encoding/json"
+import (
+	"bytes"
+	"encoding/json"
+)
 
 type NojepdqqaweusdfbiRequestParams struct {
-	Key     string `json:"KEY" validate:"required"`    // 인증키 (필수)
-	Type    string `json:"Type" validate:"required"`   // 호출 문서 타입 (xml, json) (필수)
-	Pindex  string `json:"pIndex" validate:"required"` // 페이지 위치 (필수)
-	Psize   string `json:"pSize" validate:"required"`  // 페이지 당 요청 숫자 (필수)
-	AGE     string `json:"AGE"  validate:"required"`   // 대
-	BILL_ID string `json:BILL_ID validate:"required"`  // 의안ID
+	Key     string `json:"KEY" validate:"required"`     // 인증키 (필수)
+	Type    string `json:"Type" validate:"required"`    // 호출 문서 타입 (xml, json) (필수)
+	Pindex  string `json:"pIndex" validate:"required"`  // 페이지 위치 (필수)
+	Psize   string `json:"pSize" validate:"required"`   // 페이지 당 요청 숫자 (필수)
+	AGE     string `json:"AGE"  validate:"required"`    // 대
+	BILL_ID string `json:"BILL_ID" validate:"required"` // 의안ID
 
 }
 
@@ -30,6 +33,7 @@ type NojepdqqaweusdfbiOptionalParams struct {
 // NojepdqqaweusdfbiResponse represents the top-level response structure from the API
 type NojepdqqaweusdfbiResponse struct {
 	Nojepdqqaweusdfbi []Nojepdqqaweusdfbi `json:"nojepdqqaweusdfbi"`
+	Status            *Result             `json:"RESULT,omitempty"` // 오류 응답의 RESULT
 }
 
 type Nojepdqqaweusdfbi struct {
@@ -73,3 +77,46 @@ type NojepdqqaweusdfbiRow struct {
 	Age                     json.Number `json:"AGE"`               // 대
 	MemberCode              string      `json:"MONA_CD"`           // 국회의원코드
 }
+
+// nojepdqqaweusdfbiNumberFields는 json.Number로 받는 필드입니다. 포털은 값이 없을 때 null 대신 ""를 보내기도 합니다.
+var nojepdqqaweusdfbiNumberFields = []string{"DISP_ORDER", "SESSION_CD", "CURRENTS_CD", "AGE"}
+
+// UnmarshalJSON은 숫자 필드에 빈 문자열("")이 오면 null로 취급해 행 전체의 디코딩이 실패하지 않도록 합니다.
+func (r *NojepdqqaweusdfbiRow) UnmarshalJSON(data []byte) error {
+	type plain NojepdqqaweusdfbiRow
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil || fields == nil {
+		return json.Unmarshal(data, (*plain)(r))
+	}
+
+	changed := false
+	for _, name := range nojepdqqaweusdfbiNumberFields {
+		if raw, ok := fields[name]; ok && bytes.Equal(bytes.TrimSpace(raw), []byte(`""`)) {
+			fields[name] = json.RawMessage("null")
+			changed = true
+		}
+	}
+	if changed {
+		var err error
+		if data, err = json.Marshal(fields); err != nil {
+			return err
+		}
+	}
+	return json.Unmarshal(data, (*plain)(r))
+}
+
+// AllRows는 모든 구역의 본회의 표결 행을 순서대로 모아 반환합니다.
+func (r *NojepdqqaweusdfbiResponse) AllRows() []NojepdqqaweusdfbiRow {
+	return allRows(r.Nojepdqqaweusdfbi, func(s Nojepdqqaweusdfbi) []NojepdqqaweusdfbiRow { return s.Rows })
+}
+
+// TotalCount는 조건에 맞는 본회의 표결 전체 행 수입니다.
+func (r *NojepdqqaweusdfbiResponse) TotalCount() int {
+	return totalCount(r.Nojepdqqaweusdfbi, func(s Nojepdqqaweusdfbi) []NojepdqqaweusdfbiHead { return s.Head }, func(h NojepdqqaweusdfbiHead) int { return h.ListTotalCount })
+}
+
+// Result는 본회의 표결 응답의 처리 결과입니다.
+func (r *NojepdqqaweusdfbiResponse) Result() Result {
+	return result(r.Status, r.Nojepdqqaweusdfbi, func(s Nojepdqqaweusdfbi) []NojepdqqaweusdfbiHead { return s.Head }, func(h NojepdqqaweusdfbiHead) Result { return Result(h.Result) })
+}
@@ -22,6 +22,7 @@ type NprlapfmaufmqytetOptionalParams struct {
 // 역대 국회의원 현황 Response
 type NprlapfmaufmqytetResponse struct {
 	Nprlapfmaufmqytet []Nprlapfmaufmqytet `json:"nprlapfmaufmqytet"`
+	Status            *Result             `json:"RESULT,omitempty"` // 오류 응답의 RESULT
 }
 
 // 역대 국회의원 현황 Data Structure
@@ -61,3 +62,18 @@ type NprlapfmaufmqytetRow struct {
 	Dead    string `json:"DEAD"`     // 기타정보(사망일)
 	Url     string `json:"URL"`      // 회원정보 확인 헌정회 홈페이지 URL
 }
+
+// AllRows는 모든 구역의 역대 국회의원 행을 순서대로 모아 반환합니다.
+func (r *NprlapfmaufmqytetResponse) AllRows() []NprlapfmaufmqytetRow {
+	return allRows(r.Nprlapfmaufmqytet, func(s Nprlapfmaufmqytet) []NprlapfmaufmqytetRow { return s.Rows })
+}
+
+// TotalCount는 조건에 맞는 역대 국회의원 전체 행 수입니다.
+func (r *NprlapfmaufmqytetResponse) TotalCount() int {
+	return totalCount(r.Nprlapfmaufmqytet, func(s Nprlapfmaufmqytet) []NprlapfmaufmqytetHead { return s.Head }, func(h NprlapfmaufmqytetHead) int { return h.ListTotalCount })
+}
+
+// Result는 역대 국회의원 응답의 처리 결과입니다.
+func (r *NprlapfmaufmqytetResponse) Result() Result {
+	return result(r.Status, r.Nprlapfmaufmqytet, func(s Nprlapfmaufmqytet) []NprlapfmaufmqytetHead { return s.Head }, func(h NprlapfmaufmqytetHead) Result { return Result(h.Result) })
+}
@@ -22,6 +22,7 @@ type NwvrqwxyaytdsfvhuOptionalParams struct {
 
 type NwvrqwxyaytdsfvhuResponse struct {
 	Nwvrqwxyaytdsfvhu []Nwvrqwxyaytdsfvhu `json:"nwvrqwxyaytdsfvhu"`
+	Status            *Result             `json:"RESULT,omitempty"` // 오류 응답의 RESULT
 }
 
 type Nwvrqwxyaytdsfvhu struct {
@@ -65,3 +66,18 @@ type NwvrqwxyaytdsfvhuRow struct {
 	MemTitle   *string `json:"MEM_TITLE"`    // 약력
 	AssemAddr  *string `json:"ASSEM_ADDR"`   // 사무실 호실
 }
+
+// AllRows는 모든 구역의 의원 인적사항 행을 순서대로 모아 반환합니다.
+func (r *NwvrqwxyaytdsfvhuResponse) AllRows() []NwvrqwxyaytdsfvhuRow {
+	return allRows(r.Nwvrqwxyaytdsfvhu, func(s Nwvrqwxyaytdsfvhu) []NwvrqwxyaytdsfvhuRow { return s.Rows })
+}
+
+// TotalCount는 조건에 맞는 의원 인적사항 전체 행 수입니다.
+func (r *NwvrqwxyaytdsfvhuResponse) TotalCount() int {
+	return totalCount(r.Nwvrqwxyaytdsfvhu, func(s Nwvrqwxyaytdsfvhu) []NwvrqwxyaytdsfvhuHead { return s.Head }, func(h NwvrqwxyaytdsfvhuHead) int { return h.ListTotalCount })
+}
+
+// Result는 의원 인적사항 응답의 처리 결과입니다.
+func (r *NwvrqwxyaytdsfvhuResponse) Result() Result {
+	return result(r.Status, r.Nwvrqwxyaytdsfvhu, func(s Nwvrqwxyaytdsfvhu) []NwvrqwxyaytdsfvhuHead { return s.Head }, func(h NwvrqwxyaytdsfvhuHead) Result { return Result(h.Result) })
+}
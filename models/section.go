@@ -0,0 +1,45 @@
+package models
+
+// allRows는 응답의 모든 구역에 담긴 행을 순서대로 모읍니다.
+// 실제 OpenAPI는 head와 row를 별도 배열 요소로 보내므로 첫 요소만 보면 행이 비어있을 수 있습니다.
+// 각 응답 타입의 AllRows가 구역에서 행을 꺼내는 방법(rows)만 넘겨 이 함수를 씁니다.
+func allRows[S, R any](sections []S, rows func(S) []R) []R {
+	var all []R
+	for _, section := range sections {
+		all = append(all, rows(section)...)
+	}
+	return all
+}
+
+// totalCount는 구역들의 head 중 처음으로 0보다 큰 list_total_count(조건에 맞는 전체 행 수)를 반환합니다.
+func totalCount[S, H any](sections []S, heads func(S) []H, count func(H) int) int {
+	for _, section := range sections {
+		for _, head := range heads(section) {
+			if n := count(head); n > 0 {
+				return n
+			}
+		}
+	}
+	return 0
+}
+
+// Result는 OpenAPI의 처리 결과(RESULT)입니다. 정상 응답은 head 안에, 오류 응답({"RESULT":{...}})은 최상위에 옵니다.
+type Result struct {
+	Code    string `json:"CODE"`
+	Message string `json:"MESSAGE"`
+}
+
+// result는 최상위 RESULT(status)가 있으면 그것을, 없으면 구역들의 head 중 처음으로 코드가 있는 RESULT를 반환합니다.
+func result[S, H any](status *Result, sections []S, heads func(S) []H, get func(H) Result) Result {
+	if status != nil {
+		return *status
+	}
+	for _, section := range sections {
+		for _, head := range heads(section) {
+			if r := get(head); r.Code != "" {
+				return r
+			}
+		}
+	}
+	return Result{}
+}
@@ -0,0 +1,179 @@
+package names
+
+import (
+	"assembly_go/member"
+	"cmp"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// MinScore는 FindMember가 돌려주는 최소 점수입니다.
+const MinScore = 0.7
+
+// MatchKind는 검색어가 의원의 어느 이름과 맞았는지입니다.
+type MatchKind string
+
+const (
+	MatchExact   MatchKind = "exact"   // 한글 이름 전체가 같음
+	MatchInitial MatchKind = "initial" // 초성 검색 ("ㅇㅈㅁ", "이ㅈㅁ")
+	MatchPartial MatchKind = "partial" // 한글 이름의 일부 ("재명")
+	MatchHanja   MatchKind = "hanja"   // 한자 이름
+	MatchRoman   MatchKind = "roman"   // 영문 이름이나 로마자 표기
+	MatchFuzzy   MatchKind = "fuzzy"   // 오타가 있는 한글 이름
+)
+
+// 검색 방식별 점수입니다. 오타 검색(MatchFuzzy)과 로마자 검색은 유사도를 그대로 점수로 씁니다.
+const (
+	scoreExact    = 1.0
+	scoreInitials = 0.9
+	scorePartial  = 0.85
+)
+
+// Match는 검색 결과 하나입니다. Score는 0~1이며 높을수록 검색어와 가깝습니다.
+type Match struct {
+	Member member.Member `json:"member"`
+	Score  float64       `json:"score"`
+	Kind   MatchKind     `json:"kind"`
+}
+
+// Index는 의원 목록을 이름으로 찾기 위한 색인입니다. 만든 뒤에는 읽기만 하므로 여러 고루틴에서 함께 써도 안전합니다.
+type Index struct {
+	entries []entry
+}
+
+type entry struct {
+	member member.Member
+	name   string   // 공백을 지운 한글 이름
+	hanja  string   // 공백을 지운 한자 이름
+	roman  []string // 영문 이름과 로마자 표기 후보 (소문자, 영문자만)
+}
+
+// NewIndex는 members의 색인을 만듭니다. 한글 이름이 없는 의원도 한자, 영문 이름으로 찾을 수 있습니다.
+func NewIndex(members ...member.Member) *Index {
+	x := &Index{entries: make([]entry, 0, len(members))}
+	for _, m := range members {
+		x.entries = append(x.entries, entry{
+			member: m,
+			name:   compact(m.Name),
+			hanja:  compact(m.NameHanja),
+			roman:  romanKeys(m),
+		})
+	}
+	return x
+}
+
+// Len은 색인한 의원 수입니다.
+func (x *Index) Len() int {
+	return len(x.entries)
+}
+
+// romanKeys는 영문 이름(성 이름 / 이름 성 두 순서)과, 한글 이름의 성 관용 표기 + 이름 로마자 표기를 만듭니다.
+func romanKeys(m member.Member) []string {
+	var keys []string
+	if tokens := strings.FieldsFunc(strings.ToLower(m.NameEnglish), func(r rune) bool { return !isLatin(r) }); len(tokens) > 0 {
+		keys = append(keys, strings.Join(tokens, ""), strings.Join(slices.Concat(tokens[1:], tokens[:1]), ""))
+	}
+	if surname, given := SplitName(m.Name); IsHangul(surname) {
+		g := latinOnly(Romanize(given))
+		for _, s := range SurnameSpellings(surname) {
+			keys = append(keys, s+g, g+s)
+		}
+	}
+	slices.Sort(keys)
+	return slices.Compact(keys)
+}
+
+func isLatin(r rune) bool {
+	return r < unicode.MaxASCII && unicode.IsLetter(r)
+}
+
+// latinOnly는 소문자 영문자만 남깁니다 ("Lee Jae-myung" → "leejaemyung").
+func latinOnly(s string) string {
+	return strings.Map(func(r rune) rune {
+		if isLatin(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, s)
+}
+
+// FindMember는 한글 이름, 초성, 한자 이름, 영문 이름 중 무엇으로든 의원을 찾아 점수가 높은 순으로 반환합니다.
+// 한글 검색어는 자모 단위로 비교하므로 오타가 있어도 찾고, 영문 검색어는 공백과 하이픈, 성과 이름의 순서를 무시합니다.
+// 점수가 MinScore보다 낮은 의원은 빠집니다.
+func (x *Index) FindMember(query string) []Match {
+	q := compact(query)
+	if q == "" {
+		return nil
+	}
+	var score func(e *entry) (float64, MatchKind)
+	switch {
+	case IsHanja(q):
+		score = func(e *entry) (float64, MatchKind) { return scoreHanja(e, q) }
+	case IsHangul(q):
+		score = func(e *entry) (float64, MatchKind) { return scoreHangul(e, q) }
+	default:
+		latin := latinOnly(q)
+		if latin == "" {
+			return nil
+		}
+		score = func(e *entry) (float64, MatchKind) { return scoreRoman(e, latin), MatchRoman }
+	}
+
+	var matches []Match
+	for i := range x.entries {
+		e := &x.entries[i]
+		if s, kind := score(e); s >= MinScore {
+			matches = append(matches, Match{Member: e.member, Score: s, Kind: kind})
+		}
+	}
+	slices.SortStableFunc(matches, func(a, b Match) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+		if c := strings.Compare(a.Member.Name, b.Member.Name); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Member.Code, b.Member.Code)
+	})
+	return matches
+}
+
+func scoreHangul(e *entry, q string) (float64, MatchKind) {
+	if e.name == "" {
+		return 0, ""
+	}
+	if e.name == q {
+		return scoreExact, MatchExact
+	}
+	if strings.ContainsFunc(q, IsInitial) {
+		if MatchInitials(e.name, q) {
+			return scoreInitials, MatchInitial
+		}
+		return 0, ""
+	}
+	if strings.Contains(e.name, q) {
+		return scorePartial, MatchPartial
+	}
+	return Similarity(e.name, q), MatchFuzzy
+}
+
+func scoreHanja(e *entry, q string) (float64, MatchKind) {
+	switch {
+	case e.hanja == "":
+		return 0, ""
+	case e.hanja == q:
+		return scoreExact, MatchHanja
+	case strings.Contains(e.hanja, q):
+		return scorePartial, MatchHanja
+	}
+	return Similarity(e.hanja, q), MatchHanja
+}
+
+func scoreRoman(e *entry, q string) float64 {
+	best := 0.0
+	for _, key := range e.roman {
+		best = max(best, Similarity(key, q))
+	}
+	return best
+}
@@ -0,0 +1,181 @@
+// Package names는 국회의원 이름을 한글, 한자, 로마자로 다루는 도구입니다.
+//
+// 한글 이름은 자모로 분해해 오타에 강한 비교와 초성 검색("ㅇㅈㅁ", "이ㅈㅁ")에 쓰고,
+// 국어의 로마자 표기법(2000년 문화관광부 고시)에 따라 로마자로 옮깁니다.
+// Index는 member.Member 목록에서 한글, 초성, 한자, 영문 이름 어느 것으로든 의원을 찾습니다.
+//
+//	r := member.NewResolver()
+//	r.AddMemberDetails(rows...)
+//	idx := names.NewIndex(r.Resolve().Members...)
+//	matches := idx.FindMember("이재멍") // 오타도 가장 가까운 의원부터
+package names
+
+import (
+	"strings"
+	"unicode"
+)
+
+const (
+	syllableBase  = 0xAC00 // 가
+	syllableLast  = 0xD7A3 // 힣
+	vowelCount    = 21
+	finalCount    = 28
+	syllableBlock = vowelCount * finalCount
+)
+
+// 호환용 자모 (U+3131~). 조합형 자모(U+1100~) 대신 사용자가 키보드로 입력하는 자모를 씁니다.
+var (
+	initialJamo = []rune("ㄱㄲㄴㄷㄸㄹㅁㅂㅃㅅㅆㅇㅈㅉㅊㅋㅌㅍㅎ")
+	vowelJamo   = []rune("ㅏㅐㅑㅒㅓㅔㅕㅖㅗㅘㅙㅚㅛㅜㅝㅞㅟㅠㅡㅢㅣ")
+	finalJamo   = []rune("\x00ㄱㄲㄳㄴㄵㄶㄷㄹㄺㄻㄼㄽㄾㄿㅀㅁㅂㅄㅅㅆㅇㅈㅊㅋㅌㅍㅎ")
+)
+
+// IsSyllable은 r이 완성형 한글 음절(가~힣)인지 알려줍니다.
+func IsSyllable(r rune) bool {
+	return r >= syllableBase && r <= syllableLast
+}
+
+// IsInitial은 r이 초성 검색에 쓰는 호환용 자음(ㄱ~ㅎ)인지 알려줍니다.
+func IsInitial(r rune) bool {
+	for _, j := range initialJamo {
+		if r == j {
+			return true
+		}
+	}
+	return false
+}
+
+// split은 음절을 초성, 중성, 종성 번호로 나눕니다. 종성이 없으면 0입니다.
+func split(r rune) (initial, vowel, final int) {
+	n := int(r - syllableBase)
+	return n / syllableBlock, (n % syllableBlock) / finalCount, n % finalCount
+}
+
+// Decompose는 한글 음절을 호환용 자모로 풀어 씁니다 ("한국" → "ㅎㅏㄴㄱㅜㄱ"). 한글이 아닌 글자는 그대로 둡니다.
+func Decompose(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if !IsSyllable(r) {
+			b.WriteRune(r)
+			continue
+		}
+		i, v, f := split(r)
+		b.WriteRune(initialJamo[i])
+		b.WriteRune(vowelJamo[v])
+		if f > 0 {
+			b.WriteRune(finalJamo[f])
+		}
+	}
+	return b.String()
+}
+
+// Initials는 한글 음절을 초성으로 바꿉니다 ("홍길동" → "ㅎㄱㄷ"). 한글이 아닌 글자는 그대로 둡니다.
+func Initials(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if IsSyllable(r) {
+			i, _, _ := split(r)
+			r = initialJamo[i]
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// MatchInitials는 query가 name의 연속된 부분과 맞는지 알려줍니다. query의 각 글자는 같은 음절이거나,
+// 호환용 자음이면 그 자음으로 시작하는 음절과 맞습니다 ("ㅇㅈㅁ", "이ㅈㅁ", "재ㅁ"은 모두 "이재명"과 맞음).
+// 공백은 무시합니다.
+func MatchInitials(name, query string) bool {
+	n, q := []rune(compact(name)), []rune(compact(query))
+	if len(q) == 0 || len(q) > len(n) {
+		return false
+	}
+	for start := 0; start+len(q) <= len(n); start++ {
+		ok := true
+		for i, qr := range q {
+			if !syllableMatches(n[start+i], qr) {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			return true
+		}
+	}
+	return false
+}
+
+func syllableMatches(n, q rune) bool {
+	if n == q {
+		return true
+	}
+	if IsSyllable(n) && IsInitial(q) {
+		i, _, _ := split(n)
+		return initialJamo[i] == q
+	}
+	return false
+}
+
+// IsHanja는 s에 한자(CJK 통합 한자)가 하나라도 있는지 알려줍니다.
+func IsHanja(s string) bool {
+	for _, r := range s {
+		if unicode.Is(unicode.Han, r) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsHangul은 s에 한글 음절이나 자모가 하나라도 있는지 알려줍니다.
+func IsHangul(s string) bool {
+	for _, r := range s {
+		if unicode.Is(unicode.Hangul, r) {
+			return true
+		}
+	}
+	return false
+}
+
+// compact는 공백을 모두 지웁니다.
+func compact(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+// Distance는 a와 b의 편집 거리(글자 단위 Levenshtein 거리)입니다.
+func Distance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
+
+// Similarity는 편집 거리를 긴 쪽 길이로 나눠 0(전혀 다름)~1(같음) 사이로 바꾼 값입니다.
+// 한글은 자모로 분해해 비교하므로 "이재멍"과 "이재명"처럼 모음 하나만 틀린 이름도 가깝게 나옵니다.
+func Similarity(a, b string) float64 {
+	a, b = Decompose(compact(a)), Decompose(compact(b))
+	la, lb := len([]rune(a)), len([]rune(b))
+	longest := max(la, lb)
+	if longest == 0 {
+		return 1
+	}
+	return 1 - float64(Distance(a, b))/float64(longest)
+}
@@ -0,0 +1,110 @@
+package names
+
+import (
+	"strings"
+	"unicode"
+)
+
+// 국어의 로마자 표기법 음절 표기입니다. 받침은 대표음으로 적습니다.
+var (
+	initialRoman = []string{"g", "kk", "n", "d", "tt", "r", "m", "b", "pp", "s", "ss", "", "j", "jj", "ch", "k", "t", "p", "h"}
+	vowelRoman   = []string{"a", "ae", "ya", "yae", "eo", "e", "yeo", "ye", "o", "wa", "wae", "oe", "yo", "u", "wo", "we", "wi", "yu", "eu", "ui", "i"}
+	finalRoman   = []string{"", "k", "k", "k", "n", "n", "n", "t", "l", "k", "m", "l", "l", "l", "p", "l", "m", "p", "p", "t", "t", "ng", "t", "t", "k", "t", "p", "t"}
+)
+
+const (
+	initialRieul = 5 // ㄹ (초성 번호)
+	finalRieul   = 8 // ㄹ (종성 번호)
+)
+
+// Romanize는 한글을 국어의 로마자 표기법으로 음절마다 옮깁니다 ("한복남" → "hanboknam").
+// 인명 표기 원칙에 따라 음절 사이의 음운 변화는 반영하지 않으며, "ㄹㄹ은 ll로 적는다"는 규정만 따릅니다.
+// 한글이 아닌 글자는 그대로 둡니다.
+func Romanize(s string) string {
+	var b strings.Builder
+	prevFinal := -1
+	for _, r := range s {
+		if !IsSyllable(r) {
+			b.WriteRune(r)
+			prevFinal = -1
+			continue
+		}
+		i, v, f := split(r)
+		if i == initialRieul && prevFinal == finalRieul {
+			b.WriteString("l")
+		} else {
+			b.WriteString(initialRoman[i])
+		}
+		b.WriteString(vowelRoman[v])
+		b.WriteString(finalRoman[f])
+		prevFinal = f
+	}
+	return b.String()
+}
+
+// doubleSurnames는 두 글자 성입니다. 선우, 서문처럼 한 글자 성 + 이름으로도 흔히 읽히는 성은 넣지 않았습니다.
+var doubleSurnames = []string{"남궁", "제갈", "황보", "독고", "사공"}
+
+// SplitName은 한글 이름을 성과 이름으로 나눕니다. 두 글자 성(남궁, 제갈 등)은 이름이 한 글자 이상 남을 때만 성으로 봅니다.
+func SplitName(name string) (surname, given string) {
+	name = compact(name)
+	runes := []rune(name)
+	if len(runes) < 2 {
+		return name, ""
+	}
+	for _, s := range doubleSurnames {
+		if strings.HasPrefix(name, s) && len(runes) >= 3 {
+			return s, string(runes[2:])
+		}
+	}
+	return string(runes[:1]), string(runes[1:])
+}
+
+// RomanizeName은 인명 표기 원칙에 따라 성과 이름을 띄어 쓰고 첫 글자를 대문자로 씁니다 ("홍길동" → "Hong Gildong").
+// 성은 관용 표기(김 → Kim)가 아니라 표기법대로(Gim) 적습니다. 관용 표기는 SurnameSpellings를 참고하십시오.
+func RomanizeName(name string) string {
+	surname, given := SplitName(name)
+	out := capitalize(Romanize(surname))
+	if given != "" {
+		out += " " + capitalize(Romanize(given))
+	}
+	return out
+}
+
+func capitalize(s string) string {
+	for i, r := range s {
+		return string(unicode.ToUpper(r)) + s[i+len(string(r)):]
+	}
+	return s
+}
+
+// surnameSpellings는 영문 이름(ENG_NM, NAAS_EN_NM)에 자주 쓰이는 성의 관용 표기입니다.
+var surnameSpellings = map[string][]string{
+	"김": {"kim"}, "이": {"lee", "yi", "rhee", "ri"}, "박": {"park", "pak"}, "최": {"choi", "choe"},
+	"정": {"jung", "chung", "jeong", "cheong"}, "강": {"kang"}, "조": {"cho", "jo", "joh"}, "윤": {"yoon", "yun"},
+	"장": {"jang", "chang"}, "임": {"lim", "im", "rim"}, "한": {"han"}, "오": {"oh", "o"}, "서": {"seo", "suh"},
+	"신": {"shin", "sin"}, "권": {"kwon", "gwon"}, "황": {"hwang"}, "안": {"ahn", "an"}, "송": {"song"},
+	"류": {"ryu", "yoo", "yu", "ryoo"}, "유": {"yoo", "yu", "you"}, "전": {"jeon", "jun", "chun"}, "홍": {"hong"},
+	"고": {"ko", "go", "koh"}, "문": {"moon", "mun"}, "양": {"yang"}, "손": {"son", "sohn"}, "배": {"bae", "pae"},
+	"백": {"baek", "paik", "paek"}, "허": {"heo", "huh", "hur"}, "남": {"nam"}, "심": {"shim", "sim"},
+	"노": {"noh", "roh", "no"}, "하": {"ha"}, "곽": {"kwak", "gwak"}, "성": {"sung", "seong"}, "차": {"cha"},
+	"주": {"joo", "ju", "chu"}, "우": {"woo", "u"}, "구": {"koo", "ku", "gu"}, "민": {"min"}, "나": {"na", "ra"},
+	"진": {"jin", "chin"}, "지": {"ji", "chi"}, "엄": {"eom", "um", "uhm"}, "채": {"chae"}, "원": {"won"},
+	"천": {"cheon", "chun"}, "방": {"bang"}, "공": {"kong", "gong"}, "현": {"hyun", "hyeon"}, "함": {"ham"},
+	"변": {"byun", "byeon"}, "염": {"yeom", "yum"}, "여": {"yeo", "yu"}, "추": {"choo", "chu"}, "도": {"do", "doh"},
+	"석": {"seok", "suk"}, "설": {"seol", "sul"}, "길": {"gil", "kil"}, "연": {"yeon", "yun"}, "표": {"pyo"},
+	"명": {"myung", "myeong"}, "기": {"ki", "gi"}, "용": {"yong"}, "인": {"in"}, "맹": {"maeng"},
+	"남궁": {"namgung", "namkoong"}, "제갈": {"jegal"}, "황보": {"hwangbo"},
+}
+
+// SurnameSpellings는 성의 로마자 표기 후보를 반환합니다. 표기법대로의 표기가 항상 포함됩니다.
+func SurnameSpellings(surname string) []string {
+	rr := strings.ToLower(Romanize(surname))
+	out := []string{rr}
+	for _, s := range surnameSpellings[surname] {
+		if s != rr {
+			out = append(out, s)
+		}
+	}
+	return out
+}
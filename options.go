@@ -1,18 +1,22 @@
 package assembly_go
 
 import (
+	"crypto/x509"
 	"net/http"
 	"time"
 )
 
 // Option은 Client 설정을 위한 함수 타입입니다.
+// 옵션은 설정 값만 기록하고 실제 HTTP 클라이언트는 NewClient에서 만들어지므로, 적용 순서는 결과에 영향을 주지 않습니다.
+// WithConnectTimeout 등 Transport 관련 옵션은 WithHTTPClient의 Transport가 nil이거나 *http.Transport일 때만 적용할 수 있습니다.
 type Option func(*Client)
 
 // WithHTTPClient는 사용자 정의 http.Client를 설정하는 옵션입니다.
 // 타임아웃 등을 직접 제어하고 싶을 때 유용합니다.
+// 전달한 http.Client는 복사해서 사용하므로, 다른 옵션이 원본을 수정하지 않습니다.
 func WithHTTPClient(httpClient *http.Client) Option {
 	return func(c *Client) {
-		c.httpClient = httpClient
+		c.http.baseClient = httpClient
 	}
 }
 
@@ -33,9 +37,170 @@ func WithRetries(count int) Option {
 }
 
 // WithTimeout은 요청 타임아웃을 설정하는 옵션입니다.
-// 이 옵션은 새로운 http.Client를 생성하여 설정합니다.
+// 이 옵션은 새로운 http.Client를 생성하여 설정하며, WithHTTPClient와 함께 써도 원본 클라이언트는 바뀌지 않습니다.
+// WithDownloadTimeout이 없으면 PDF 다운로드에도 같은 타임아웃이 적용됩니다.
 func WithTimeout(timeout time.Duration) Option {
 	return func(c *Client) {
-		c.httpClient.Timeout = timeout
+		c.http.timeout = timeout
+	}
+}
+
+// WithDownloadTimeout은 PDF 다운로드 요청의 전체 타임아웃을 설정하는 옵션입니다.
+// 큰 회의록 파일을 받을 때 OpenAPI 요청보다 긴 타임아웃을 줄 수 있습니다.
+func WithDownloadTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.http.downloadTimeout = timeout
+	}
+}
+
+// WithConnectTimeout은 TCP 연결과 TLS 핸드셰이크에 걸리는 시간의 상한을 설정하는 옵션입니다.
+// 전체 타임아웃(WithTimeout, WithDownloadTimeout)과 별개로, 응답을 받는 시간은 제한하지 않습니다.
+func WithConnectTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.http.connectTimeout = timeout
+	}
+}
+
+// WithMaxIdleConns는 전체 유휴 커넥션 풀 크기를 설정하는 옵션입니다.
+func WithMaxIdleConns(n int) Option {
+	return func(c *Client) {
+		c.http.maxIdleConns = n
+	}
+}
+
+// WithMaxIdleConnsPerHost는 호스트별 유휴 커넥션 풀 크기를 설정하는 옵션입니다.
+// 동시에 많은 PDF를 내려받을 때 기본값(2)보다 크게 잡으면 커넥션 재사용률이 높아집니다.
+func WithMaxIdleConnsPerHost(n int) Option {
+	return func(c *Client) {
+		c.http.maxIdleConnsPerHost = n
+	}
+}
+
+// WithMaxConnsPerHost는 호스트별 최대 동시 커넥션 수를 설정하는 옵션입니다.
+func WithMaxConnsPerHost(n int) Option {
+	return func(c *Client) {
+		c.http.maxConnsPerHost = n
+	}
+}
+
+// WithIdleConnTimeout은 유휴 커넥션을 닫기 전까지 유지하는 시간을 설정하는 옵션입니다.
+func WithIdleConnTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.http.idleConnTimeout = timeout
+	}
+}
+
+// WithProxyURL은 모든 요청에 사용할 프록시 URL을 설정하는 옵션입니다.
+// URL이 잘못되었으면 NewClient가 ErrInvalidConfig 에러를 반환합니다.
+func WithProxyURL(proxyURL string) Option {
+	return func(c *Client) {
+		c.http.proxyURL = proxyURL
+	}
+}
+
+// WithRootCAs는 서버 인증서 검증에 사용할 루트 CA 풀을 설정하는 옵션입니다.
+func WithRootCAs(pool *x509.CertPool) Option {
+	return func(c *Client) {
+		c.http.rootCAs = pool
+	}
+}
+
+// WithRootCAFile은 PEM 파일의 인증서를 루트 CA로 추가하는 옵션입니다.
+// 파일은 NewClient에서 읽으며, 읽을 수 없거나 인증서가 없으면 ErrInvalidConfig 에러를 반환합니다.
+func WithRootCAFile(path string) Option {
+	return func(c *Client) {
+		c.http.rootCAFile = path
+	}
+}
+
+// WithHTTP2는 HTTP/2 사용 여부를 설정하는 옵션입니다.
+// 일부 프록시나 게이트웨이에서 HTTP/2 연결이 불안정할 때 false로 끌 수 있습니다.
+func WithHTTP2(enabled bool) Option {
+	return func(c *Client) {
+		c.http.http2 = &enabled
+	}
+}
+
+// WithValidatorStore는 조건부 다운로드에 사용할 검증자 저장소를 설정하는 옵션입니다.
+// 기본값은 프로세스 메모리에만 보관하는 MemoryValidatorStore입니다.
+// 실행 간에 검증자를 유지하려면 NewFileValidatorStore로 만든 저장소를 전달합니다.
+func WithValidatorStore(store ValidatorStore) Option {
+	return func(c *Client) {
+		if store != nil {
+			c.validators = store
+		}
+	}
+}
+
+// WithAPIKeys는 여러 API Key를 순서대로 사용하도록 설정하는 옵션입니다.
+// 현재 키가 호출 한도 초과나 인증키 오류 코드를 받으면 다음 키로 자동 전환합니다.
+// 이 옵션을 사용하면 NewClient의 apiKey 인자는 무시됩니다.
+func WithAPIKeys(keys ...string) Option {
+	return func(c *Client) {
+		if p := NewRotatingKeyProvider(keys...); p.Len() > 0 {
+			c.keys = p
+		}
+	}
+}
+
+// WithKeyProvider는 사용자 정의 KeyProvider를 설정하는 옵션입니다.
+// 비밀 저장소에서 키를 불러오거나 여러 프로세스가 키 사용량을 공유할 때 사용합니다.
+func WithKeyProvider(provider KeyProvider) Option {
+	return func(c *Client) {
+		if provider != nil {
+			c.keys = provider
+		}
+	}
+}
+
+// WithFileBaseURL은 법안 PDF를 내려받는 filegate의 기본 URL을 설정하는 옵션입니다.
+// 설정하지 않으면 WithBaseURL로 지정한 URL을 사용합니다.
+func WithFileBaseURL(url string) Option {
+	return func(c *Client) {
+		c.fileBaseURL = url
+	}
+}
+
+// WithUserAgent는 모든 요청에 사용할 User-Agent 헤더 값을 설정하는 옵션입니다.
+// 기본값은 "assembly-go/<버전>" 형식의 DefaultUserAgent입니다.
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithBrowserUserAgent는 PDF 파일 게이트웨이 요청에 브라우저 User-Agent(BrowserUserAgent)를 사용하는 옵션입니다.
+// 브라우저가 아닌 요청을 HTML 오류 페이지로 거부하는 게이트웨이가 있을 때만 켜십시오.
+// OpenAPI 요청에는 영향을 주지 않습니다.
+func WithBrowserUserAgent() Option {
+	return func(c *Client) {
+		c.browserUA = true
+	}
+}
+
+// WithHeader는 모든 요청에 추가할 기본 헤더를 설정하는 옵션입니다.
+// 같은 이름으로 여러 번 지정하면 마지막 값이 사용되며, SDK가 설정하는 헤더보다 우선합니다.
+func WithHeader(key, value string) Option {
+	return func(c *Client) {
+		if c.headers == nil {
+			c.headers = make(http.Header)
+		}
+		c.headers.Set(key, value)
+	}
+}
+
+// WithRateLimit은 초당 최대 요청 수를 설정하는 옵션입니다.
+// 0 이하의 값은 속도 제한을 두지 않습니다. 기본값은 제한 없음입니다.
+func WithRateLimit(requestsPerSecond float64) Option {
+	return func(c *Client) {
+		c.limiter = newRateLimiter(requestsPerSecond)
+	}
+}
+
+// WithoutTermNormalization은 대수 인자(AGE, DAESU, ERACO)를 주어진 그대로 보내는 옵션입니다.
+// 기본적으로 Client는 "제22대"와 "22"를 각 인자가 받는 형식으로 바꿔 보냅니다.
+func WithoutTermNormalization() Option {
+	return func(c *Client) {
+		c.rawTerms = true
 	}
 }
@@ -0,0 +1,47 @@
+package assembly_go
+
+import "iter"
+
+// PageFetcher는 pIndex 번째 페이지를 pSize 행씩 조회해 행과 전체 행 수(list_total_count)를 반환하는 함수입니다.
+type PageFetcher[T any] func(pIndex, pSize int) ([]T, int, error)
+
+// Paginate는 페이지 단위 조회를 행 단위 반복자로 바꿉니다.
+// 페이지가 pageSize보다 짧거나 전체 행 수에 도달하면 멈추며, 조회에 실패하면 0값과 에러를 한 번 내보내고 멈춥니다.
+//
+//	pages := assembly_go.Paginate(100, func(pIndex, pSize int) ([]models.TVBPMBILL11Row, int, error) {
+//		resp, err := client.FetchBillsWithOptions(models.TVBPMBILL11RequestParams{
+//			Pindex: strconv.Itoa(pIndex), Psize: strconv.Itoa(pSize)}, models.TVBPMBILL11OptionalParams{AGE: "22"})
+//		if err == nil {
+//			err = assembly_go.CheckResult(resp)
+//		}
+//		if err != nil {
+//			return nil, 0, err
+//		}
+//		return resp.AllRows(), resp.TotalCount(), nil
+//	})
+//	for row, err := range pages { ... }
+func Paginate[T any](pageSize int, fetch PageFetcher[T]) iter.Seq2[T, error] {
+	if pageSize <= 0 {
+		pageSize = 100
+	}
+	return func(yield func(T, error) bool) {
+		seen := 0
+		for page := 1; ; page++ {
+			rows, total, err := fetch(page, pageSize)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, row := range rows {
+				if !yield(row, nil) {
+					return
+				}
+			}
+			seen += len(rows)
+			if len(rows) < pageSize || (total > 0 && seen >= total) {
+				return
+			}
+		}
+	}
+}
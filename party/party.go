@@ -0,0 +1,436 @@
+// Package party는 표결(POLY_NM), 의원 정보(POLY_NM, PLPT_NM), 역대 의원 현황(DAE)에 나오는 정당 이름을
+// 정당 ID로 맞추고, 합당과 당명 변경으로 이어지는 계보를 제공합니다.
+//
+// 같은 조직이 이름만 바꾼 경우(한나라당 → 새누리당 → 자유한국당)는 한 Party의 Aliases이고,
+// 합당으로 새 정당이 생긴 경우(자유한국당 + 새로운보수당 → 미래통합당)는 Predecessors/Successors로 잇습니다.
+// "민주당", "국민의당"처럼 시기마다 다른 정당이 쓴 이름은 날짜로 구분합니다.
+//
+//	reg := party.NewRegistry()
+//	p, _ := reg.Resolve("민주당", time.Date(2013, 6, 1, 0, 0, 0, 0, time.UTC)) // 민주통합당 계열
+//	latest, _ := reg.Latest(p.ID)                                                // 더불어민주당
+//
+//	// 대수가 달라도 같은 계열 정당으로 묶어 표결 통계 내기
+//	a := votes.NewAnalyzer(votes.WithPartyNormalizer(reg.Normalize))
+package party
+
+import (
+	"assembly_go/terms"
+	"cmp"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+var (
+	// ErrUnknownParty는 등록되지 않은 정당 ID를 참조했을 때 발생합니다.
+	ErrUnknownParty = errors.New("unknown party")
+	// ErrDuplicateParty는 이미 등록된 정당 ID를 다시 등록할 때 발생합니다.
+	ErrDuplicateParty = errors.New("duplicate party")
+)
+
+// IDIndependent는 무소속의 ID입니다.
+const IDIndependent = "independent"
+
+// Alias는 정당이 한 이름을 쓴 기간입니다. From과 To는 첫날과 마지막 날이며 둘 다 포함합니다. To가 0이면 지금도 쓰는 이름입니다.
+type Alias struct {
+	Name string    `json:"name"`
+	From time.Time `json:"from,omitzero"`
+	To   time.Time `json:"to,omitzero"`
+}
+
+// Contains는 at(날짜만 봅니다)에 이 이름을 쓰고 있었는지 알려줍니다. 기간이 비어있는 별칭은 언제나 true입니다.
+func (a Alias) Contains(at time.Time) bool {
+	d := day(at)
+	return (a.From.IsZero() || !d.Before(a.From)) && (a.To.IsZero() || !d.After(a.To))
+}
+
+// Party는 당명 변경을 거쳐도 이어지는 정당 하나입니다.
+type Party struct {
+	ID           string   `json:"id"`
+	Name         string   `json:"name"`                   // 마지막 이름
+	Aliases      []Alias  `json:"aliases"`                // 이름을 쓴 기간 (시간 순)
+	Predecessors []string `json:"predecessors,omitempty"` // 합당해 이 정당이 된 정당 ID
+	Successors   []string `json:"successors,omitempty"`   // 이 정당이 합당해 들어간 정당 ID (Predecessors로 계산)
+}
+
+// Founded는 창당일입니다. 모르면 0입니다.
+func (p Party) Founded() time.Time {
+	if len(p.Aliases) == 0 {
+		return time.Time{}
+	}
+	return p.Aliases[0].From
+}
+
+// Dissolved는 합당, 해산으로 없어진 날입니다. 지금도 있는 정당은 0입니다.
+func (p Party) Dissolved() time.Time {
+	if len(p.Aliases) == 0 {
+		return time.Time{}
+	}
+	return p.Aliases[len(p.Aliases)-1].To
+}
+
+// NameAt은 at에 쓰던 이름입니다. 그 날 정당이 없었으면 빈 문자열입니다.
+func (p Party) NameAt(at time.Time) string {
+	for _, a := range p.Aliases {
+		if a.Contains(at) {
+			return a.Name
+		}
+	}
+	return ""
+}
+
+var kst = time.FixedZone("KST", 9*60*60)
+
+func date(s string) time.Time {
+	if s == "" {
+		return time.Time{}
+	}
+	t, err := time.ParseInLocation("2006-01-02", s, kst)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func day(t time.Time) time.Time {
+	t = t.In(kst)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, kst)
+}
+
+func alias(name, from, to string) Alias {
+	return Alias{Name: name, From: date(from), To: date(to)}
+}
+
+// builtin은 제16대 이후 원내 정당의 주요 계보입니다. 날짜는 창당, 당명 변경, 합당일 기준이며
+// 군소 정당과 비교섭단체 정당 일부는 빠져 있으므로 필요하면 Register로 더하십시오.
+// Register는 앞선 정당이 먼저 등록되어 있어야 하므로 합당 전 정당을 먼저 적습니다.
+var builtin = []Party{
+	{ID: IDIndependent, Name: "무소속", Aliases: []Alias{{Name: "무소속"}}},
+
+	// 보수 계열
+	{ID: "grand-national", Aliases: []Alias{
+		alias("한나라당", "1997-11-21", "2012-02-12"),
+		alias("새누리당", "2012-02-13", "2017-02-12"),
+		alias("자유한국당", "2017-02-13", "2020-02-16"),
+	}},
+	{ID: "bareun", Aliases: []Alias{alias("바른정당", "2017-01-24", "2018-02-12")}},
+	{ID: "new-conservative", Aliases: []Alias{alias("새로운보수당", "2020-01-05", "2020-02-16")}},
+	{ID: "future-korea", Aliases: []Alias{alias("미래한국당", "2020-02-05", "2020-05-27")}},
+	{ID: "people-future", Aliases: []Alias{alias("국민의미래", "2024-02-23", "2024-05-01")}},
+
+	// 민주 계열
+	{ID: "millennium-democratic", Aliases: []Alias{
+		alias("새천년민주당", "2000-01-20", "2005-05-05"),
+		alias("민주당", "2005-05-06", "2008-02-16"),
+	}},
+	{ID: "uri", Aliases: []Alias{alias("열린우리당", "2003-11-11", "2007-08-19")}},
+	{ID: "united-democratic", Predecessors: []string{"uri", "millennium-democratic"}, Aliases: []Alias{
+		alias("대통합민주신당", "2007-08-05", "2008-02-16"),
+		alias("통합민주당", "2008-02-17", "2008-07-05"),
+		alias("민주당", "2008-07-06", "2011-12-15"),
+	}},
+	{ID: "democratic-united", Predecessors: []string{"united-democratic"}, Aliases: []Alias{
+		alias("민주통합당", "2011-12-16", "2013-05-03"),
+		alias("민주당", "2013-05-04", "2014-03-25"),
+	}},
+	{ID: "platform-party", Aliases: []Alias{alias("더불어시민당", "2020-03-08", "2020-05-17")}},
+	{ID: "together-democratic", Aliases: []Alias{alias("더불어민주연합", "2024-03-03", "2024-05-02")}},
+	{ID: "democratic", Predecessors: []string{"democratic-united", "platform-party", "together-democratic"}, Aliases: []Alias{
+		alias("새정치민주연합", "2014-03-26", "2015-12-27"),
+		alias("더불어민주당", "2015-12-28", ""),
+	}},
+
+	// 제3지대
+	{ID: "people-party-2016", Aliases: []Alias{alias("국민의당", "2016-02-02", "2018-02-12")}},
+	{ID: "bareun-future", Predecessors: []string{"bareun", "people-party-2016"}, Aliases: []Alias{alias("바른미래당", "2018-02-13", "2020-02-23")}},
+	{ID: "peace-democratic", Aliases: []Alias{alias("민주평화당", "2018-02-06", "2020-02-23")}},
+	{ID: "minsaeng", Predecessors: []string{"bareun-future", "peace-democratic"}, Aliases: []Alias{alias("민생당", "2020-02-24", "")}},
+	{ID: "people-party-2020", Aliases: []Alias{alias("국민의당", "2020-02-23", "2022-04-18")}},
+	{ID: "reform", Aliases: []Alias{alias("개혁신당", "2024-01-20", "")}},
+	{ID: "rebuilding-korea", Aliases: []Alias{alias("조국혁신당", "2024-03-03", "")}},
+
+	// 보수 계열 통합 (제3지대의 국민의당(2020)이 합류)
+	{ID: "people-power", Predecessors: []string{"grand-national", "new-conservative", "future-korea", "people-party-2020", "people-future"}, Aliases: []Alias{
+		alias("미래통합당", "2020-02-17", "2020-09-01"),
+		alias("국민의힘", "2020-09-02", ""),
+	}},
+
+	// 진보 계열
+	{ID: "unified-progressive", Aliases: []Alias{alias("통합진보당", "2011-12-05", "2014-12-19")}},
+	{ID: "justice", Aliases: []Alias{
+		alias("진보정의당", "2012-10-21", "2013-07-20"),
+		alias("정의당", "2013-07-21", ""),
+	}},
+	{ID: "progressive", Aliases: []Alias{
+		alias("민중당", "2017-10-15", "2020-06-18"),
+		alias("진보당", "2020-06-19", ""),
+	}},
+}
+
+// Registry는 정당 목록과 이름 색인입니다. 여러 고루틴에서 함께 써도 안전합니다.
+// 0값은 쓸 수 없으므로 NewRegistry로 만드십시오.
+type Registry struct {
+	mu     sync.RWMutex
+	byID   map[string]*Party
+	order  []string
+	byName map[string][]string // key(이름) → 그 이름을 쓴 정당 ID
+}
+
+// NewRegistry는 내장 정당 계보를 담은 Registry를 만듭니다.
+func NewRegistry() *Registry {
+	r := &Registry{byID: make(map[string]*Party), byName: make(map[string][]string)}
+	for _, p := range builtin {
+		if err := r.Register(p); err != nil {
+			panic(err)
+		}
+	}
+	return r
+}
+
+// key는 이름 비교에 쓰는 키입니다. 공백을 지웁니다.
+func key(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, name)
+}
+
+// Register는 정당을 추가합니다. Name이 비어있으면 마지막 별칭을 씁니다.
+// Predecessors는 이미 등록된 정당이어야 하며, 그 정당들의 Successors에 p가 더해집니다.
+func (r *Registry) Register(p Party) error {
+	if p.ID == "" || len(p.Aliases) == 0 {
+		return fmt.Errorf("%w: party needs an ID and at least one alias", ErrUnknownParty)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.byID[p.ID]; ok {
+		return fmt.Errorf("%w: %s", ErrDuplicateParty, p.ID)
+	}
+	for _, id := range p.Predecessors {
+		if _, ok := r.byID[id]; !ok {
+			return fmt.Errorf("%w: predecessor %s of %s", ErrUnknownParty, id, p.ID)
+		}
+	}
+
+	p.Aliases = slices.Clone(p.Aliases)
+	slices.SortStableFunc(p.Aliases, func(a, b Alias) int { return a.From.Compare(b.From) })
+	if p.Name == "" {
+		p.Name = p.Aliases[len(p.Aliases)-1].Name
+	}
+	p.Predecessors = slices.Clone(p.Predecessors)
+	p.Successors = nil
+	r.byID[p.ID] = &p
+	r.order = append(r.order, p.ID)
+	for _, id := range p.Predecessors {
+		r.byID[id].Successors = append(r.byID[id].Successors, p.ID)
+	}
+	for _, a := range p.Aliases {
+		k := key(a.Name)
+		if !slices.Contains(r.byName[k], p.ID) {
+			r.byName[k] = append(r.byName[k], p.ID)
+		}
+	}
+	return nil
+}
+
+func clone(p *Party) Party {
+	out := *p
+	out.Aliases = slices.Clone(p.Aliases)
+	out.Predecessors = slices.Clone(p.Predecessors)
+	out.Successors = slices.Clone(p.Successors)
+	return out
+}
+
+// Get은 ID로 정당을 찾습니다.
+func (r *Registry) Get(id string) (Party, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	p, ok := r.byID[id]
+	if !ok {
+		return Party{}, false
+	}
+	return clone(p), true
+}
+
+// All은 등록된 정당을 등록 순서대로 반환합니다.
+func (r *Registry) All() []Party {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	out := make([]Party, len(r.order))
+	for i, id := range r.order {
+		out[i] = clone(r.byID[id])
+	}
+	return out
+}
+
+// Resolve는 정당 이름을 at 시점의 정당으로 바꿉니다. 앞뒤 공백과 이름 속 공백은 무시합니다.
+// 같은 이름을 쓴 정당이 여럿이면 at에 그 이름을 쓰던 정당을 고르고, 그런 정당이 없거나 at이 0이면
+// 그 이름을 쓴 기간이 at에 가장 가까운(at이 0이면 가장 최근) 정당을 고릅니다.
+func (r *Registry) Resolve(name string, at time.Time) (Party, bool) {
+	k := key(name)
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var (
+		best     *Party
+		bestDist time.Duration
+	)
+	for _, id := range r.byName[k] {
+		p := r.byID[id]
+		for _, a := range p.Aliases {
+			if key(a.Name) != k {
+				continue
+			}
+			dist := distance(a, at)
+			if best == nil || dist < bestDist {
+				best, bestDist = p, dist
+			}
+		}
+	}
+	if best == nil {
+		return Party{}, false
+	}
+	return clone(best), true
+}
+
+// distance는 at이 별칭 기간에서 떨어진 정도입니다. 기간 안이면 0이고, at이 0이면 최근에 쓴 이름일수록 작습니다.
+func distance(a Alias, at time.Time) time.Duration {
+	if at.IsZero() {
+		if a.To.IsZero() {
+			return 0
+		}
+		return time.Since(a.To)
+	}
+	if a.Contains(at) {
+		return 0
+	}
+	d := day(at)
+	if !a.From.IsZero() && d.Before(a.From) {
+		return a.From.Sub(d)
+	}
+	return d.Sub(a.To)
+}
+
+// Lineage는 id의 앞선 정당들을 창당일 순으로, 마지막에 id 자신을 붙여 반환합니다.
+// 나중에 합류한 정당(예: 국민의힘에 합당한 국민의당)은 id보다 늦게 창당했어도 id 앞에 옵니다.
+func (r *Registry) Lineage(id string) ([]Party, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if _, ok := r.byID[id]; !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownParty, id)
+	}
+	seen := map[string]bool{id: true}
+	queue := []string{id}
+	var out []Party
+	for len(queue) > 0 {
+		p := r.byID[queue[0]]
+		queue = queue[1:]
+		out = append(out, clone(p))
+		for _, pred := range p.Predecessors {
+			if !seen[pred] && r.byID[pred] != nil {
+				seen[pred] = true
+				queue = append(queue, pred)
+			}
+		}
+	}
+	self, ancestors := out[0], out[1:]
+	slices.SortStableFunc(ancestors, func(a, b Party) int { return cmp.Compare(a.Founded().Unix(), b.Founded().Unix()) })
+	return append(ancestors, self), nil
+}
+
+// Latest는 합당을 따라가 id 계열의 마지막 정당을 반환합니다. 합당한 적이 없으면 그 정당 자신입니다.
+func (r *Registry) Latest(id string) (Party, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	p, ok := r.byID[id]
+	if !ok {
+		return Party{}, false
+	}
+	seen := map[string]bool{id: true}
+	for len(p.Successors) > 0 && !seen[p.Successors[0]] {
+		seen[p.Successors[0]] = true
+		p = r.byID[p.Successors[0]]
+	}
+	return clone(p), true
+}
+
+// Normalize는 date(YYYY-MM-DD, YYYYMMDD, YYYY.MM.DD. 비어있어도 됩니다)에 쓰인 정당 이름 name을
+// 그 계열 마지막 정당의 이름으로 바꿉니다. 모르는 이름은 앞뒤 공백만 지워 반환합니다.
+// votes.WithPartyNormalizer에 그대로 넘길 수 있습니다.
+func (r *Registry) Normalize(name, date string) string {
+	p, ok := r.Resolve(name, parseDate(date))
+	if !ok {
+		return strings.TrimSpace(name)
+	}
+	latest, _ := r.Latest(p.ID)
+	return latest.Name
+}
+
+func parseDate(s string) time.Time {
+	s = strings.TrimSpace(s)
+	for _, layout := range []string{"2006-01-02", "20060102", "2006.01.02"} {
+		if t, err := time.ParseInLocation(layout, s, kst); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// Affiliation은 역대 국회의원 현황 DAE 값에서 읽은 대수 하나의 소속 정당입니다.
+type Affiliation struct {
+	Term  int    `json:"term,omitempty"` // 대수. DAE에 대수가 없으면 0입니다.
+	Party string `json:"party"`          // 적힌 그대로의 정당(단체) 이름
+}
+
+var termMarker = regexp.MustCompile(`제\s*\d+\s*대`)
+
+// ParseAffiliation은 "제20대 새누리당", "제19대(민주통합당) 제20대(더불어민주당)"처럼 대수와 정당이 섞인 DAE 값을 나눕니다.
+// 대수 표시가 없으면 전체를 정당 이름으로 봅니다. 정당 이름 앞뒤의 괄호, 쉼표, 공백은 지웁니다.
+func ParseAffiliation(dae string) []Affiliation {
+	const trim = " \t()（）[],/"
+	locs := termMarker.FindAllStringIndex(dae, -1)
+	if len(locs) == 0 {
+		if name := strings.Trim(dae, trim); name != "" {
+			return []Affiliation{{Party: name}}
+		}
+		return nil
+	}
+	var out []Affiliation
+	for i, loc := range locs {
+		end := len(dae)
+		if i+1 < len(locs) {
+			end = locs[i+1][0]
+		}
+		n, _ := terms.Number(dae[loc[0]:loc[1]])
+		out = append(out, Affiliation{Term: n, Party: strings.Trim(dae[loc[1]:end], trim)})
+	}
+	return out
+}
+
+// ResolveAffiliation은 DAE 값의 정당 이름을 각 대수 임기 시작일 기준으로 정당에 맞춥니다.
+// 맞출 수 없는 항목은 빈 Party로 남깁니다.
+func (r *Registry) ResolveAffiliation(dae string) []ResolvedAffiliation {
+	affs := ParseAffiliation(dae)
+	out := make([]ResolvedAffiliation, len(affs))
+	for i, a := range affs {
+		var at time.Time
+		if t, ok := terms.Get(a.Term); ok {
+			at = t.Start
+		}
+		out[i].Affiliation = a
+		out[i].Resolved, _ = r.Resolve(a.Party, at)
+	}
+	return out
+}
+
+// ResolvedAffiliation은 정당을 찾아 붙인 Affiliation입니다.
+type ResolvedAffiliation struct {
+	Affiliation
+	Resolved Party `json:"resolved,omitzero"`
+}
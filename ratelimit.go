@@ -0,0 +1,50 @@
+package assembly_go
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// rateLimiter는 요청 사이의 최소 간격을 보장하는 단순한 속도 제한기입니다.
+// 여러 고루틴이 동시에 요청해도 전체 요청 속도가 설정값을 넘지 않도록 예약 시각을 순서대로 배정합니다.
+type rateLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+// newRateLimiter는 초당 requestsPerSecond회 요청을 허용하는 rateLimiter를 생성합니다.
+// 0 이하의 값은 속도 제한을 두지 않는다는 뜻이므로 nil을 반환합니다.
+func newRateLimiter(requestsPerSecond float64) *rateLimiter {
+	if requestsPerSecond <= 0 {
+		return nil
+	}
+	return &rateLimiter{interval: time.Duration(float64(time.Second) / requestsPerSecond)}
+}
+
+// wait는 다음 요청을 보내도 될 때까지 대기합니다. nil 수신자는 즉시 반환합니다.
+func (l *rateLimiter) wait() {
+	if l == nil {
+		return
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	delay := l.next.Sub(now)
+	l.next = l.next.Add(l.interval)
+	l.mu.Unlock()
+
+	if delay > 0 {
+		time.Sleep(delay)
+	}
+}
+
+// do는 속도 제한을 지킨 뒤 hc로 요청을 보냅니다.
+func (c *Client) do(hc *http.Client, req *http.Request) (*http.Response, error) {
+	c.limiter.wait()
+	return hc.Do(req)
+}
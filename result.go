@@ -0,0 +1,104 @@
+package assembly_go
+
+import (
+	"assembly_go/models"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// 열린국회정보 OpenAPI가 RESULT.CODE로 돌려주는 주요 결과 코드입니다.
+const (
+	ResultCodeOK           = "INFO-000"  // 정상 처리되었습니다.
+	ResultCodeNoData       = "INFO-200"  // 해당하는 데이터가 없습니다.
+	ResultCodeQuotaLimit   = "INFO-300"  // 유효 호출건수를 이미 초과하셨습니다.
+	ResultCodeNoPermission = "INFO-400"  // 권한이 없습니다.
+	ResultCodeInvalidKey   = "ERROR-290" // 인증키가 유효하지 않습니다.
+	ResultCodeMissingParam = "ERROR-300" // 필수 값이 누락되어 있습니다.
+	ResultCodeNoService    = "ERROR-310" // 해당하는 서비스를 찾을 수 없습니다.
+	ResultCodePageSize     = "ERROR-336" // 데이터요청은 한번에 최대 1,000건을 넘을 수 없습니다.
+	ResultCodeDailyLimit   = "ERROR-337" // 일별 트래픽 제한을 넘은 호출입니다.
+	ResultCodeServerError  = "ERROR-500" // 서버 오류입니다.
+)
+
+// APIError는 OpenAPI가 HTTP 200 응답 안의 RESULT 코드로 오류를 알렸을 때 반환됩니다.
+// Client는 인증키 오류와 호출 한도 초과에만 APIError를 반환하고, 그 밖의 오류 응답은 본문을 그대로 돌려줍니다.
+// 그런 응답은 CheckResult로 확인합니다.
+type APIError struct {
+	Code    string // RESULT.CODE (예: ERROR-290)
+	Message string // RESULT.MESSAGE
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("assembly openapi error %s: %s", e.Code, e.Message)
+}
+
+// CheckResult는 응답의 RESULT 코드가 정상(INFO-000)이나 데이터 없음(INFO-200)이 아니면 *APIError를 반환합니다.
+// RESULT가 없는 응답은 정상으로 봅니다. 오류 응답은 빈 응답으로 디코딩되므로,
+// 조회 결과가 비었을 때 서버 오류와 데이터 없음을 구분하려면 이 함수로 확인해야 합니다.
+func CheckResult(resp interface{ Result() models.Result }) error {
+	r := resp.Result()
+	switch r.Code {
+	case "", ResultCodeOK, ResultCodeNoData:
+		return nil
+	}
+	return &APIError{Code: r.Code, Message: r.Message}
+}
+
+// IsKeyQuotaCode는 code가 인증키의 호출 한도 초과를 뜻하는지 확인합니다.
+func IsKeyQuotaCode(code string) bool {
+	return code == ResultCodeQuotaLimit || code == ResultCodeDailyLimit
+}
+
+// IsInvalidKeyCode는 code가 인증키 자체의 문제(유효하지 않음, 권한 없음)를 뜻하는지 확인합니다.
+func IsInvalidKeyCode(code string) bool {
+	return code == ResultCodeInvalidKey || code == ResultCodeNoPermission
+}
+
+// isErrorCode는 code가 인증키 사용 현황(KeyUsage.Errors)에 오류로 셀 결과 코드인지 확인합니다.
+// INFO-200(데이터 없음)은 빈 응답으로 취급하므로 에러가 아닙니다.
+func isErrorCode(code string) bool {
+	return strings.HasPrefix(code, "ERROR-") || IsKeyQuotaCode(code) || IsInvalidKeyCode(code)
+}
+
+// resultEnvelope는 결과 코드만 확인하기 위한 최소한의 응답 구조입니다.
+// 오류 응답은 {"RESULT":{...}} 형태로, 정상 응답은 {"<endpoint>":[{"head":[...]}]} 형태로 옵니다.
+type resultEnvelope struct {
+	Code    string `json:"CODE"`
+	Message string `json:"MESSAGE"`
+}
+
+// parseResultCode는 응답 본문에서 RESULT 코드와 메시지를 찾아 반환합니다.
+// JSON이 아니거나 코드를 찾지 못하면 빈 문자열을 반환합니다.
+func parseResultCode(body []byte) (code, message string) {
+	var top map[string]json.RawMessage
+	if err := json.Unmarshal(body, &top); err != nil {
+		return "", ""
+	}
+
+	if raw, ok := top["RESULT"]; ok {
+		var r resultEnvelope
+		if json.Unmarshal(raw, &r) == nil {
+			return r.Code, r.Message
+		}
+	}
+
+	for _, raw := range top {
+		var sections []struct {
+			Head []struct {
+				Result *resultEnvelope `json:"RESULT"`
+			} `json:"head"`
+		}
+		if json.Unmarshal(raw, &sections) != nil {
+			continue
+		}
+		for _, section := range sections {
+			for _, head := range section.Head {
+				if head.Result != nil {
+					return head.Result.Code, head.Result.Message
+				}
+			}
+		}
+	}
+	return "", ""
+}
@@ -0,0 +1,150 @@
+package sink
+
+import (
+	"assembly_go"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// utf8BOM은 Excel이 UTF-8 CSV의 한글을 깨뜨리지 않고 열도록 파일 앞에 붙이는 바이트 순서 표시입니다.
+const utf8BOM = "\ufeff"
+
+// csvConfig는 CSVOption으로 바꿀 수 있는 설정입니다.
+type csvConfig struct {
+	bom     bool
+	header  bool
+	columns []string
+	comma   rune
+	useCRLF bool
+}
+
+// CSVOption은 CSV 설정을 변경하는 함수 타입입니다.
+type CSVOption func(*csvConfig)
+
+// WithBOM은 파일 앞에 UTF-8 BOM을 씁니다. Excel에서 한글이 깨지지 않게 하려면 사용합니다.
+func WithBOM() CSVOption {
+	return func(c *csvConfig) {
+		c.bom = true
+	}
+}
+
+// WithoutHeader는 첫 줄에 열 이름을 쓰지 않습니다. 기존 파일 뒤에 이어 쓸 때 사용합니다.
+func WithoutHeader() CSVOption {
+	return func(c *csvConfig) {
+		c.header = false
+	}
+}
+
+// WithColumns는 쓸 열과 그 순서를 JSON 태그 이름으로 지정합니다. 기본값은 구조체의 모든 필드를 선언 순서대로 씁니다.
+func WithColumns(names ...string) CSVOption {
+	return func(c *csvConfig) {
+		c.columns = names
+	}
+}
+
+// WithComma는 구분자를 설정합니다. 기본값은 쉼표입니다.
+func WithComma(comma rune) CSVOption {
+	return func(c *csvConfig) {
+		c.comma = comma
+	}
+}
+
+// WithCRLF는 줄 끝에 \r\n을 씁니다.
+func WithCRLF() CSVOption {
+	return func(c *csvConfig) {
+		c.useCRLF = true
+	}
+}
+
+// CSV는 행을 CSV로 쓰는 Sink입니다. 열 이름은 모델의 JSON 태그이며, 순서는 구조체 선언 순서라 실행마다 같습니다.
+type CSV[Row any] struct {
+	w       *csv.Writer
+	out     io.Writer
+	cfg     csvConfig
+	columns []column
+	started bool
+	record  []string
+}
+
+// NewCSV는 w에 CSV를 쓰는 Sink를 생성합니다. Row가 구조체가 아니거나 WithColumns에 없는 열 이름이 있으면 에러를 반환합니다.
+func NewCSV[Row any](w io.Writer, options ...CSVOption) (*CSV[Row], error) {
+	cfg := csvConfig{header: true, comma: ','}
+	for _, opt := range options {
+		opt(&cfg)
+	}
+
+	all, err := columnsOf[Row]()
+	if err != nil {
+		return nil, err
+	}
+	columns := all
+	if len(cfg.columns) > 0 {
+		byName := make(map[string]column, len(all))
+		for _, c := range all {
+			byName[strings.ToUpper(c.name)] = c
+		}
+		columns = make([]column, 0, len(cfg.columns))
+		for _, name := range cfg.columns {
+			c, ok := byName[strings.ToUpper(name)]
+			if !ok {
+				return nil, fmt.Errorf("%w: unknown column %q for %s", assembly_go.ErrInvalidConfig, name, reflect.TypeFor[Row]())
+			}
+			columns = append(columns, c)
+		}
+	}
+
+	cw := csv.NewWriter(w)
+	cw.Comma = cfg.comma
+	cw.UseCRLF = cfg.useCRLF
+	return &CSV[Row]{w: cw, out: w, cfg: cfg, columns: columns, record: make([]string, len(columns))}, nil
+}
+
+// Columns는 쓸 열 이름을 순서대로 반환합니다.
+func (s *CSV[Row]) Columns() []string {
+	names := make([]string, len(s.columns))
+	for i, c := range s.columns {
+		names[i] = c.name
+	}
+	return names
+}
+
+// start는 첫 행을 쓰기 전에 BOM과 머리글을 씁니다.
+func (s *CSV[Row]) start() error {
+	if s.started {
+		return nil
+	}
+	s.started = true
+	if s.cfg.bom {
+		if _, err := io.WriteString(s.out, utf8BOM); err != nil {
+			return err
+		}
+	}
+	if s.cfg.header {
+		return s.w.Write(s.Columns())
+	}
+	return nil
+}
+
+// Write는 row를 한 줄로 씁니다. nil 포인터 필드는 빈 칸이 됩니다.
+func (s *CSV[Row]) Write(row Row) error {
+	if err := s.start(); err != nil {
+		return err
+	}
+	v := reflect.ValueOf(row)
+	for i, c := range s.columns {
+		s.record[i] = fieldText(v.Field(c.index))
+	}
+	return s.w.Write(s.record)
+}
+
+// Close는 버퍼에 남은 내용을 씁니다. 행을 하나도 쓰지 않았어도 BOM과 머리글은 씁니다.
+func (s *CSV[Row]) Close() error {
+	if err := s.start(); err != nil {
+		return err
+	}
+	s.w.Flush()
+	return s.w.Error()
+}
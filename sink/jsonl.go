@@ -0,0 +1,31 @@
+package sink
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+)
+
+// JSONL은 행마다 JSON 객체 한 줄을 쓰는 Sink입니다. 필드 이름은 모델의 JSON 태그(포털의 필드 이름)를 따릅니다.
+type JSONL[Row any] struct {
+	w   *bufio.Writer
+	enc *json.Encoder
+}
+
+// NewJSONL은 w에 JSON Lines를 쓰는 Sink를 생성합니다.
+func NewJSONL[Row any](w io.Writer) *JSONL[Row] {
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+	enc.SetEscapeHTML(false)
+	return &JSONL[Row]{w: bw, enc: enc}
+}
+
+// Write는 row를 한 줄로 씁니다.
+func (s *JSONL[Row]) Write(row Row) error {
+	return s.enc.Encode(row)
+}
+
+// Close는 버퍼에 남은 내용을 씁니다.
+func (s *JSONL[Row]) Close() error {
+	return s.w.Flush()
+}
@@ -0,0 +1,204 @@
+package sink
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Partitioner는 행을 저장할 하위 디렉터리(슬래시로 구분)를 정합니다.
+type Partitioner[Row any] func(row Row) (string, error)
+
+// ByField는 JSON 태그 이름이 name인 필드의 값으로 나눕니다. 값이 비어있으면 "unknown"입니다.
+// Row에 그 필드가 없으면 Write가 에러를 반환합니다.
+func ByField[Row any](name string) Partitioner[Row] {
+	index, err := fieldIndex[Row](name)
+	return func(row Row) (string, error) {
+		if err != nil {
+			return "", err
+		}
+		return fieldText(reflect.ValueOf(row).Field(index)), nil
+	}
+}
+
+// ByAge는 대수(AGE 필드)로 나눕니다. 법안, 표결, 역대 의원 등 AGE가 있는 모든 모델에 쓸 수 있습니다.
+func ByAge[Row any]() Partitioner[Row] {
+	return ByField[Row]("AGE")
+}
+
+// ByMonth는 날짜 필드(예: "PROPOSE_DT", "CONF_DT")의 연월("2024-06")로 나눕니다.
+// "2024-06-01"과 "20240601" 형식을 읽으며, 비어있거나 읽을 수 없는 값은 "unknown"입니다.
+func ByMonth[Row any](name string) Partitioner[Row] {
+	byField := ByField[Row](name)
+	return func(row Row) (string, error) {
+		v, err := byField(row)
+		if err != nil {
+			return "", err
+		}
+		digits := strings.Map(func(r rune) rune {
+			if r >= '0' && r <= '9' {
+				return r
+			}
+			return -1
+		}, v)
+		if len(digits) < 6 {
+			return "", nil
+		}
+		return digits[:4] + "-" + digits[4:6], nil
+	}
+}
+
+// Nested는 여러 Partitioner의 결과를 차례로 하위 디렉터리로 만듭니다. 예: Nested(ByAge(), ByMonth("PROPOSE_DT"))는 "22/2024-06"입니다.
+func Nested[Row any](parts ...Partitioner[Row]) Partitioner[Row] {
+	return func(row Row) (string, error) {
+		segments := make([]string, len(parts))
+		for i, p := range parts {
+			s, err := p(row)
+			if err != nil {
+				return "", err
+			}
+			segments[i] = s
+		}
+		return strings.Join(segments, "/"), nil
+	}
+}
+
+// Factory는 w에 쓰는 Sink를 만듭니다. Partitioned가 파티션마다 파일을 열 때 사용합니다.
+type Factory[Row any] func(w io.Writer) (Sink[Row], error)
+
+// JSONLFactory는 파티션마다 JSONL Sink를 만듭니다.
+func JSONLFactory[Row any]() Factory[Row] {
+	return func(w io.Writer) (Sink[Row], error) {
+		return NewJSONL[Row](w), nil
+	}
+}
+
+// CSVFactory는 파티션마다 같은 설정의 CSV Sink를 만듭니다.
+func CSVFactory[Row any](options ...CSVOption) Factory[Row] {
+	return func(w io.Writer) (Sink[Row], error) {
+		return NewCSV[Row](w, options...)
+	}
+}
+
+// partFile은 열려 있는 파티션 파일입니다.
+type partFile[Row any] struct {
+	file *os.File
+	sink Sink[Row]
+}
+
+// Partitioned는 행을 "<dir>/<파티션>/<fileName>"에 나누어 쓰는 Sink입니다.
+// 파티션 파일은 처음 쓸 때 새로 만들며(기존 내용은 지워짐), Close할 때까지 열어 둡니다.
+type Partitioned[Row any] struct {
+	dir       string
+	fileName  string
+	partition Partitioner[Row]
+	factory   Factory[Row]
+	files     map[string]*partFile[Row]
+}
+
+// NewPartitioned는 Partitioned Sink를 생성합니다.
+//
+//	s := sink.NewPartitioned("mirror", "bills.jsonl", sink.ByAge[models.TVBPMBILL11Row](), sink.JSONLFactory[models.TVBPMBILL11Row]())
+//	// mirror/22/bills.jsonl, mirror/21/bills.jsonl ...
+func NewPartitioned[Row any](dir, fileName string, partition Partitioner[Row], factory Factory[Row]) *Partitioned[Row] {
+	return &Partitioned[Row]{dir: dir, fileName: fileName, partition: partition, factory: factory, files: make(map[string]*partFile[Row])}
+}
+
+// Write는 row를 파티션 파일에 씁니다.
+func (p *Partitioned[Row]) Write(row Row) error {
+	key, err := p.partition(row)
+	if err != nil {
+		return err
+	}
+	key = partitionPath(key)
+
+	part, ok := p.files[key]
+	if !ok {
+		if part, err = p.open(key); err != nil {
+			return err
+		}
+		p.files[key] = part
+	}
+	return part.sink.Write(row)
+}
+
+func (p *Partitioned[Row]) open(key string) (*partFile[Row], error) {
+	path := filepath.Join(p.dir, filepath.FromSlash(key), p.fileName)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, err
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	s, err := p.factory(f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return &partFile[Row]{file: f, sink: s}, nil
+}
+
+// Paths는 지금까지 쓴 파티션 파일 경로를 정렬해 반환합니다.
+func (p *Partitioned[Row]) Paths() []string {
+	paths := make([]string, 0, len(p.files))
+	for key := range p.files {
+		paths = append(paths, filepath.Join(p.dir, filepath.FromSlash(key), p.fileName))
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// Close는 모든 파티션의 Sink와 파일을 닫습니다.
+func (p *Partitioned[Row]) Close() error {
+	var errs []error
+	for key, part := range p.files {
+		if err := part.sink.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("partition %s: %w", key, err))
+		}
+		if err := part.file.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("partition %s: %w", key, err))
+		}
+		delete(p.files, key)
+	}
+	return errors.Join(errs...)
+}
+
+// partitionPath는 파티션 값의 각 구간을 디렉터리 이름으로 쓸 수 있게 정리합니다. 빈 구간은 "unknown"이 됩니다.
+func partitionPath(key string) string {
+	segments := strings.Split(key, "/")
+	for i, s := range segments {
+		s = strings.Map(func(r rune) rune {
+			if strings.ContainsRune(`\:*?"<>|`, r) || unicode.IsControl(r) || unicode.IsSpace(r) {
+				return '_'
+			}
+			return r
+		}, strings.TrimSpace(s))
+		s = strings.Trim(s, "._")
+		if s == "" {
+			s = "unknown"
+		}
+		segments[i] = s
+	}
+	return strings.Join(segments, "/")
+}
+
+// fieldIndex는 Row에서 JSON 태그 이름이 name인 필드의 인덱스를 찾습니다.
+func fieldIndex[Row any](name string) (int, error) {
+	columns, err := columnsOf[Row]()
+	if err != nil {
+		return 0, err
+	}
+	for _, c := range columns {
+		if strings.EqualFold(c.name, name) {
+			return c.index, nil
+		}
+	}
+	return 0, fmt.Errorf("sink: %s has no field %q", reflect.TypeFor[Row](), name)
+}
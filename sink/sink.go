@@ -0,0 +1,98 @@
+// Package sink는 Fetch 메서드가 반환한 행을 파일로 저장하는 Sink와 기본 구현을 제공합니다.
+//
+// JSONL은 행마다 JSON 한 줄을, CSV는 구조체 태그 순서의 열로 쓰며, Partitioned는 대수(AGE)나 날짜별 디렉터리에 나누어 씁니다.
+// assembly_go.Paginate가 만든 반복자를 Copy에 넘기면 모든 페이지의 행을 바로 저장할 수 있습니다.
+//
+//	f, _ := os.Create("bills.csv")
+//	defer f.Close()
+//	s, _ := sink.NewCSV[models.TVBPMBILL11Row](f, sink.WithBOM())
+//	n, err := sink.Copy(s, assembly_go.Paginate(100, fetchBills))
+package sink
+
+import (
+	"encoding/json"
+	"fmt"
+	"iter"
+	"reflect"
+	"strings"
+)
+
+// Sink는 행을 차례로 받아 저장하는 곳입니다. Close는 버퍼에 남은 내용을 쓰고 Sink가 연 자원을 닫습니다.
+// 생성할 때 받은 io.Writer는 닫지 않습니다.
+type Sink[Row any] interface {
+	Write(row Row) error
+	Close() error
+}
+
+// Copy는 rows의 행을 모두 s에 쓰고 쓴 행 수를 반환합니다. 반복자가 에러를 내보내면 그 자리에서 멈춥니다.
+// s는 닫지 않으므로 호출한 쪽에서 Close해야 합니다.
+func Copy[Row any](s Sink[Row], rows iter.Seq2[Row, error]) (int, error) {
+	n := 0
+	for row, err := range rows {
+		if err != nil {
+			return n, err
+		}
+		if err := s.Write(row); err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, nil
+}
+
+// WriteAll은 rows를 모두 s에 씁니다. 한 페이지의 AllRows 결과를 저장할 때 사용합니다.
+func WriteAll[Row any](s Sink[Row], rows []Row) error {
+	for _, row := range rows {
+		if err := s.Write(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// column은 행 구조체의 필드 하나입니다.
+type column struct {
+	name  string // JSON 태그 이름
+	index int
+}
+
+// columnsOf는 Row의 필드를 구조체 순서대로 JSON 태그 이름과 함께 반환합니다. 태그가 "-"인 필드와 내보내지 않는 필드는 제외합니다.
+func columnsOf[Row any]() ([]column, error) {
+	t := reflect.TypeFor[Row]()
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("sink: row type %s is not a struct", t)
+	}
+	var columns []column
+	for i := range t.NumField() {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		columns = append(columns, column{name: name, index: i})
+	}
+	return columns, nil
+}
+
+// fieldText는 필드 값을 문자열로 바꿉니다. nil 포인터는 빈 문자열입니다.
+func fieldText(v reflect.Value) string {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+	switch x := v.Interface().(type) {
+	case string:
+		return x
+	case json.Number:
+		return x.String()
+	}
+	return fmt.Sprint(v.Interface())
+}
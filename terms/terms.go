@@ -0,0 +1,183 @@
+// Package terms는 국회 대수(제1대~제22대)의 임기와, 엔드포인트마다 다른 대수 표기("22", "제22대") 사이의 변환을 제공합니다.
+//
+// OpenAPI는 대수를 AGE, DAESU에서는 숫자("22")로, ERACO에서는 "제22대"로 받습니다.
+// assembly_go.Client는 요청을 보낼 때 NormalizeParam으로 이 인자들을 알맞은 표기로 바꿉니다.
+package terms
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// ErrUnknownTerm은 대수 표기를 읽을 수 없거나 등록되지 않은 대수일 때 발생합니다.
+var ErrUnknownTerm = errors.New("unknown assembly term")
+
+// Term은 국회 한 대수의 임기입니다. Start와 End는 한국 시간 기준 첫날과 마지막 날 0시이며, 둘 다 임기에 포함됩니다.
+type Term struct {
+	Number int       `json:"number"`
+	Start  time.Time `json:"start"`
+	End    time.Time `json:"end"` // 현재 대수는 예정된 임기 만료일입니다.
+}
+
+// Label은 "제22대" 형식의 이름입니다. ERACO 인자와 회의록 행의 ERACO 값이 이 형식입니다.
+func (t Term) Label() string {
+	return Label(t.Number)
+}
+
+// Age는 "22" 형식의 이름입니다. AGE, DAESU 인자가 이 형식입니다.
+func (t Term) Age() string {
+	return strconv.Itoa(t.Number)
+}
+
+func (t Term) String() string {
+	return t.Label()
+}
+
+// Contains는 date(날짜만 봅니다)가 임기 안인지 알려줍니다.
+func (t Term) Contains(date time.Time) bool {
+	d := day(date)
+	return !d.Before(t.Start) && !d.After(t.End)
+}
+
+// kst는 임기 날짜의 기준 시간대입니다.
+var kst = time.FixedZone("KST", 9*60*60)
+
+func date(s string) time.Time {
+	t, err := time.ParseInLocation("2006-01-02", s, kst)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// registry는 대수별 임기입니다. 5대와 6대, 8대와 9대 사이에는 국회가 없던 기간이 있습니다.
+var registry = []Term{
+	{1, date("1948-05-31"), date("1950-05-30")},
+	{2, date("1950-05-31"), date("1954-05-30")},
+	{3, date("1954-05-31"), date("1958-05-30")},
+	{4, date("1958-05-31"), date("1960-07-28")},
+	{5, date("1960-07-29"), date("1961-05-16")},
+	{6, date("1963-12-17"), date("1967-06-30")},
+	{7, date("1967-07-01"), date("1971-06-30")},
+	{8, date("1971-07-01"), date("1972-10-17")},
+	{9, date("1973-03-12"), date("1979-03-11")},
+	{10, date("1979-03-12"), date("1980-10-27")},
+	{11, date("1981-04-11"), date("1985-04-10")},
+	{12, date("1985-04-11"), date("1988-05-29")},
+	{13, date("1988-05-30"), date("1992-05-29")},
+	{14, date("1992-05-30"), date("1996-05-29")},
+	{15, date("1996-05-30"), date("2000-05-29")},
+	{16, date("2000-05-30"), date("2004-05-29")},
+	{17, date("2004-05-30"), date("2008-05-29")},
+	{18, date("2008-05-30"), date("2012-05-29")},
+	{19, date("2012-05-30"), date("2016-05-29")},
+	{20, date("2016-05-30"), date("2020-05-29")},
+	{21, date("2020-05-30"), date("2024-05-29")},
+	{22, date("2024-05-30"), date("2028-05-29")},
+}
+
+// All은 등록된 모든 대수를 순서대로 반환합니다.
+func All() []Term {
+	return append([]Term(nil), registry...)
+}
+
+// Latest는 등록된 마지막 대수입니다.
+func Latest() Term {
+	return registry[len(registry)-1]
+}
+
+// Get은 n대를 반환합니다.
+func Get(n int) (Term, bool) {
+	if n < 1 || n > len(registry) {
+		return Term{}, false
+	}
+	return registry[n-1], true
+}
+
+// TermForDate는 t가 속한 대수를 반환합니다. 국회가 없던 기간이나 등록된 범위 밖이면 false입니다.
+func TermForDate(t time.Time) (Term, bool) {
+	d := day(t)
+	for _, term := range registry {
+		if term.Contains(d) {
+			return term, true
+		}
+	}
+	return Term{}, false
+}
+
+// Parse는 "22", "제22대", "22대", "제 22 대" 같은 표기를 읽어 등록된 대수를 반환합니다.
+func Parse(s string) (Term, error) {
+	n, ok := Number(s)
+	if !ok {
+		return Term{}, fmt.Errorf("%w: %q", ErrUnknownTerm, s)
+	}
+	t, ok := Get(n)
+	if !ok {
+		return Term{}, fmt.Errorf("%w: %q", ErrUnknownTerm, s)
+	}
+	return t, nil
+}
+
+// Number는 대수 표기에서 숫자를 읽습니다. 등록 여부는 확인하지 않으므로 아직 등록되지 않은 다음 대수도 읽습니다.
+// "제"와 "대"는 없어도 되지만, 그 밖의 글자가 있거나 1보다 작으면 false입니다.
+func Number(s string) (int, bool) {
+	s = strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, s)
+	s = strings.TrimPrefix(s, "제")
+	s = strings.TrimSuffix(s, "대")
+	if s == "" || len(s) > 3 {
+		return 0, false
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 1 || strings.HasPrefix(s, "+") || strings.HasPrefix(s, "-") {
+		return 0, false
+	}
+	return n, true
+}
+
+// Label은 n을 "제n대"로 씁니다.
+func Label(n int) string {
+	return "제" + strconv.Itoa(n) + "대"
+}
+
+// NormalizeAge는 대수 표기를 AGE, DAESU 형식("22")으로 바꿉니다. 읽을 수 없는 값은 그대로 반환합니다.
+func NormalizeAge(s string) string {
+	if n, ok := Number(s); ok {
+		return strconv.Itoa(n)
+	}
+	return s
+}
+
+// NormalizeEraco는 대수 표기를 ERACO 형식("제22대")으로 바꿉니다. 읽을 수 없는 값은 그대로 반환합니다.
+func NormalizeEraco(s string) string {
+	if n, ok := Number(s); ok {
+		return Label(n)
+	}
+	return s
+}
+
+// NormalizeParam은 요청 인자 name이 대수 인자(AGE, DAESU, ERACO)면 value를 그 인자의 형식으로 바꿉니다.
+// 다른 인자나 읽을 수 없는 값은 그대로 반환합니다.
+func NormalizeParam(name, value string) string {
+	switch name {
+	case "AGE", "DAESU":
+		return NormalizeAge(value)
+	case "ERACO":
+		return NormalizeEraco(value)
+	}
+	return value
+}
+
+// day는 t를 한국 시간 기준 그날 0시로 바꿉니다.
+func day(t time.Time) time.Time {
+	t = t.In(kst)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, kst)
+}
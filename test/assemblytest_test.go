@@ -0,0 +1,180 @@
+package assembly_go_test
+
+import (
+	"assembly_go"
+	"assembly_go/assemblytest"
+	"assembly_go/models"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestAssemblyTestServer(t *testing.T) {
+	srv := assemblytest.NewServer()
+	defer srv.Close()
+
+	for i := 1; i <= 25; i++ {
+		age := "22"
+		if i > 20 {
+			age = "21"
+		}
+		srv.AddBills(models.TVBPMBILL11Row{BillId: fmt.Sprintf("B%02d", i), Age: age})
+	}
+	srv.AddBillConferences(models.VCONFBILLCONFLISTRow{BillId: "B01", ConferenceId: "C1"})
+
+	client, err := srv.NewClient(assembly_go.WithRetries(1))
+	if err != nil {
+		t.Fatalf("클라이언트 생성 시 에러 발생: %v", err)
+	}
+
+	t.Run("페이지 나누기", func(t *testing.T) {
+		resp, err := client.FetchBills(models.TVBPMBILL11RequestParams{Pindex: "3", Psize: "10"})
+		if err != nil {
+			t.Fatalf("요청 중 에러 발생: %v", err)
+		}
+		rows := resp.AllRows()
+		if resp.TotalCount() != 25 || len(rows) != 5 || rows[0].BillId != "B21" {
+			t.Errorf("3페이지는 B21부터 5건이어야 합니다: total=%d, rows=%+v", resp.TotalCount(), rows)
+		}
+	})
+
+	t.Run("필터", func(t *testing.T) {
+		data, err := client.FetchApiData(assemblytest.EndpointBills, http.MethodGet, map[string]string{"AGE": "21", "pSize": "100"})
+		if err != nil {
+			t.Fatalf("요청 중 에러 발생: %v", err)
+		}
+		var resp models.TVBPMBILL11Response
+		if err := json.Unmarshal(data, &resp); err != nil {
+			t.Fatal(err)
+		}
+		if resp.TotalCount() != 5 || len(resp.AllRows()) != 5 {
+			t.Errorf("AGE=21 필터 결과는 5건이어야 합니다: %d", resp.TotalCount())
+		}
+	})
+
+	t.Run("데이터가 없으면 INFO-200 빈 응답", func(t *testing.T) {
+		resp, err := client.FetchBills(models.TVBPMBILL11RequestParams{Pindex: "99", Psize: "10"})
+		if err != nil {
+			t.Fatalf("INFO-200은 에러가 아니어야 합니다: %v", err)
+		}
+		if len(resp.AllRows()) != 0 {
+			t.Errorf("빈 응답이어야 합니다: %+v", resp)
+		}
+	})
+
+	t.Run("필수 인자 누락과 페이지 크기 초과", func(t *testing.T) {
+		missing, err := client.FetchBillConferenceList(models.VCONFBILLCONFLISTRequestParams{Pindex: 1, Psize: 10})
+		if err == nil {
+			err = assembly_go.CheckResult(missing)
+		}
+		var apiErr *assembly_go.APIError
+		if !errors.As(err, &apiErr) || apiErr.Code != assembly_go.ResultCodeMissingParam {
+			t.Errorf("BILL_ID가 없으면 ERROR-300이어야 합니다: %v", err)
+		}
+
+		resp, err := client.FetchBillConferenceList(models.VCONFBILLCONFLISTRequestParams{Pindex: 1, Psize: 10, BILL_ID: "B01"})
+		if err != nil || len(resp.AllRows()) != 1 {
+			t.Errorf("BILL_ID가 있으면 1건이어야 합니다: %v, %+v", err, resp)
+		}
+
+		bills, err := client.FetchBills(models.TVBPMBILL11RequestParams{Pindex: "1", Psize: "1001"})
+		if err == nil {
+			err = assembly_go.CheckResult(bills)
+		}
+		if !errors.As(err, &apiErr) || apiErr.Code != assembly_go.ResultCodePageSize {
+			t.Errorf("pSize가 1000을 넘으면 ERROR-336이어야 합니다: %v", err)
+		}
+	})
+
+	t.Run("RESULT 코드와 5xx 장애 주입", func(t *testing.T) {
+		srv.InjectFault(assemblytest.Fault{Path: assemblytest.EndpointBills, Times: 1, ResultCode: assembly_go.ResultCodeServerError})
+		srv.InjectFault(assemblytest.Fault{Path: assemblytest.EndpointBills, Times: 1, Status: http.StatusServiceUnavailable})
+
+		resp, err := client.FetchBills(models.TVBPMBILL11RequestParams{})
+		if err != nil {
+			t.Fatalf("RESULT 오류는 본문으로 돌려받아야 합니다: %v", err)
+		}
+		if len(resp.AllRows()) != 0 || resp.Result().Code != assembly_go.ResultCodeServerError {
+			t.Errorf("ERROR-500 결과를 기대했지만: %+v", resp)
+		}
+		var apiErr *assembly_go.APIError
+		if err := assembly_go.CheckResult(resp); !errors.As(err, &apiErr) || apiErr.Code != assembly_go.ResultCodeServerError {
+			t.Errorf("ERROR-500 APIError를 기대했지만: %v", err)
+		}
+		if _, err := client.FetchBills(models.TVBPMBILL11RequestParams{}); !errors.Is(err, assembly_go.ErrDownloadFailed) {
+			t.Errorf("503 응답은 ErrDownloadFailed여야 합니다: %v", err)
+		}
+		if _, err := client.FetchBills(models.TVBPMBILL11RequestParams{}); err != nil {
+			t.Errorf("장애 횟수를 모두 쓴 뒤에는 정상이어야 합니다: %v", err)
+		}
+	})
+
+	t.Run("지연 장애", func(t *testing.T) {
+		srv.InjectFault(assemblytest.Fault{Path: assemblytest.EndpointBills, Times: 1, Latency: 100 * time.Millisecond})
+		slowClient, _ := srv.NewClient(assembly_go.WithTimeout(20 * time.Millisecond))
+		if _, err := slowClient.FetchBills(models.TVBPMBILL11RequestParams{}); err == nil {
+			t.Error("지연 장애로 타임아웃이 발생해야 합니다.")
+		}
+	})
+
+	t.Run("인증키 할당량 초과 시 키 전환", func(t *testing.T) {
+		srv.SetQuota("K1", 1)
+		rotating, _ := srv.NewClient(assembly_go.WithAPIKeys("K1", "K2"))
+		for i := 0; i < 3; i++ {
+			if _, err := rotating.FetchBills(models.TVBPMBILL11RequestParams{}); err != nil {
+				t.Fatalf("%d번째 요청 중 에러 발생: %v", i+1, err)
+			}
+		}
+		usage := rotating.KeyProvider().(*assembly_go.RotatingKeyProvider).Usage()
+		if !usage[0].Exhausted || usage[1].Requests != 2 {
+			t.Errorf("K1 한도 초과 후 K2로 전환되어야 합니다: %+v", usage)
+		}
+	})
+}
+
+func TestAssemblyTestServerFiles(t *testing.T) {
+	srv := assemblytest.NewServer()
+	defer srv.Close()
+
+	pdf := []byte("%PDF-1.4 bill content %%EOF")
+	srv.AddBillPDF("BOOK1", pdf)
+	recordURL := srv.AddFile("/records/1.pdf", []byte("%PDF-1.4 record"))
+	client, _ := srv.NewClient(assembly_go.WithRetries(1))
+
+	t.Run("법안 PDF와 조건부 요청", func(t *testing.T) {
+		data, err := client.DownloadBill("BOOK1")
+		if err != nil || !bytes.Equal(data, pdf) {
+			t.Fatalf("법안 PDF 다운로드 실패: %v", err)
+		}
+		first, _ := client.DownloadBillIfModified("BOOK1")
+		second, err := client.DownloadBillIfModified("BOOK1")
+		if err != nil || first.NotModified || !second.NotModified {
+			t.Errorf("두 번째 조건부 요청은 NotModified여야 합니다: %v, %+v, %+v", err, first, second)
+		}
+		if _, err := client.DownloadMeetingRecord(recordURL); err != nil {
+			t.Errorf("회의록 다운로드 실패: %v", err)
+		}
+	})
+
+	t.Run("없는 법안은 HTML 오류 페이지", func(t *testing.T) {
+		if _, err := client.DownloadBill("MISSING"); !errors.Is(err, assembly_go.ErrHTMLContent) {
+			t.Errorf("예상 에러: %v, 실제 에러: %v", assembly_go.ErrHTMLContent, err)
+		}
+	})
+
+	t.Run("HTML 페이지와 잘린 PDF 장애", func(t *testing.T) {
+		srv.InjectFault(assemblytest.Fault{Path: assemblytest.FileGatePath, Times: 1, HTML: true})
+		if _, err := client.DownloadBill("BOOK1"); !errors.Is(err, assembly_go.ErrHTMLContent) {
+			t.Errorf("예상 에러: %v, 실제 에러: %v", assembly_go.ErrHTMLContent, err)
+		}
+
+		srv.InjectFault(assemblytest.Fault{Path: assemblytest.FileGatePath, Times: 1, TruncateAt: 8})
+		if _, err := client.DownloadBill("BOOK1"); !errors.Is(err, assembly_go.ErrDownloadFailed) {
+			t.Errorf("잘린 응답은 ErrDownloadFailed여야 합니다: %v", err)
+		}
+	})
+}
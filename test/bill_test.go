@@ -0,0 +1,114 @@
+package assembly_go_test
+
+import (
+	"assembly_go"
+	"assembly_go/assemblytest"
+	"assembly_go/models"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestGetBill(t *testing.T) {
+	srv := assemblytest.NewServer()
+	defer srv.Close()
+	client, _ := srv.NewClient(assembly_go.WithRetries(1))
+
+	bill := models.TVBPMBILL11Row{BillId: "PRC_A", BillNumber: "2200001", Age: "22", BillName: "가법"}
+	meetings := []models.VCONFBILLCONFLISTRow{
+		{BillId: "PRC_A", ConferenceId: "C1", ConferenceDate: "2024-06-03"},
+		{BillId: "PRC_A", ConferenceId: "C2", ConferenceDate: "2024-06-20"},
+		{BillId: "PRC_B", ConferenceId: "C3"},
+	}
+	votes := []models.NojepdqqaweusdfbiRow{
+		{BillID: "PRC_A", Age: "22", MemberCode: "M1", VoteResult: "찬성"},
+		{BillID: "PRC_A", Age: "22", MemberCode: "M2", VoteResult: "찬성"},
+		{BillID: "PRC_A", Age: "22", MemberCode: "M3", VoteResult: "반대"},
+		{BillID: "PRC_A", Age: "22", MemberCode: "M4", VoteResult: "기권"},
+		{BillID: "PRC_A", Age: "22", MemberCode: "M5", VoteResult: "불참"},
+		{BillID: "PRC_B", Age: "22", MemberCode: "M1", VoteResult: "찬성"},
+	}
+	srv.AddBills(bill, models.TVBPMBILL11Row{BillId: "PRC_B", Age: "22"})
+	srv.AddBillConferences(meetings...)
+	srv.AddVotes(votes...)
+
+	t.Run("정상 조회", func(t *testing.T) {
+		got, err := client.GetBill(context.Background(), "PRC_A")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Info.BillName != "가법" || len(got.Meetings) != 2 || len(got.Votes) != 5 {
+			t.Errorf("결과값: %+v", got)
+		}
+		want := assembly_go.VoteTally{Yes: 2, No: 1, Abstain: 1, Absent: 1, Total: 5}
+		if got.Tally != want {
+			t.Errorf("기대값: %+v, 결과값: %+v", want, got.Tally)
+		}
+		if !strings.Contains(got.PDFURL, assemblytest.FileGatePath) || !strings.Contains(got.PDFURL, "bookId=PRC_A") {
+			t.Errorf("PDF 주소가 올바르지 않습니다: %s", got.PDFURL)
+		}
+		if got.Partial() {
+			t.Error("일부 실패로 표시되면 안 됩니다")
+		}
+	})
+
+	t.Run("없는 법안", func(t *testing.T) {
+		_, err := client.GetBill(context.Background(), "PRC_NONE")
+		if !errors.Is(err, assembly_go.ErrNotFound) {
+			t.Errorf("기대값: %v, 결과값: %v", assembly_go.ErrNotFound, err)
+		}
+		if _, err := client.GetBill(context.Background(), ""); !errors.Is(err, assembly_go.ErrInvalidID) {
+			t.Errorf("기대값: %v, 결과값: %v", assembly_go.ErrInvalidID, err)
+		}
+	})
+
+	t.Run("표결 조회 실패는 일부 결과", func(t *testing.T) {
+		srv.InjectFault(assemblytest.Fault{Path: assemblytest.EndpointVotes, ResultCode: "ERROR-500", Times: 5})
+		defer srv.ClearFaults()
+		got, err := client.GetBill(context.Background(), "PRC_A")
+		if !errors.Is(err, assembly_go.ErrPartialResult) {
+			t.Fatalf("기대값: %v, 결과값: %v", assembly_go.ErrPartialResult, err)
+		}
+		var apiErr *assembly_go.APIError
+		if got == nil || !got.Partial() || got.VotesErr == nil || got.MeetingsErr != nil || !errors.As(err, &apiErr) {
+			t.Fatalf("결과값: %+v (%v)", got, err)
+		}
+		if len(got.Meetings) != 2 || got.Tally.Total != 0 {
+			t.Errorf("회의록은 채워지고 표결은 비어야 합니다: %+v", got)
+		}
+	})
+
+	t.Run("메타데이터 조회의 RESULT 오류", func(t *testing.T) {
+		srv.InjectFault(assemblytest.Fault{Path: assemblytest.EndpointBills, ResultCode: "ERROR-500", Times: 1})
+		defer srv.ClearFaults()
+		_, err := client.GetBill(context.Background(), "PRC_A")
+		var apiErr *assembly_go.APIError
+		if errors.Is(err, assembly_go.ErrNotFound) || !errors.As(err, &apiErr) || apiErr.Code != assembly_go.ResultCodeServerError {
+			t.Errorf("ERROR-500 APIError를 기대했지만: %v", err)
+		}
+	})
+
+	t.Run("취소된 컨텍스트", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if _, err := client.GetBill(ctx, "PRC_A"); !errors.Is(err, context.Canceled) {
+			t.Errorf("기대값: %v, 결과값: %v", context.Canceled, err)
+		}
+	})
+
+	t.Run("로컬 저장소", func(t *testing.T) {
+		store := openStore(t, t.TempDir())
+		store.Bills().Put(bill)
+		store.BillConferences().Put(meetings...)
+		store.Votes().Put(votes...)
+
+		got, err := assembly_go.NewBillService(store).GetBill(context.Background(), "PRC_A")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got.Meetings) != 2 || got.Tally.Total != 5 || got.PDFURL != "" {
+			t.Errorf("결과값: %+v", got)
+		}
+	})
+}
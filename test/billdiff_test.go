@@ -0,0 +1,120 @@
+package assembly_go_test
+
+import (
+	"assembly_go"
+	"assembly_go/billdiff"
+	"assembly_go/models"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func strPtr(s string) *string { return &s }
+
+func TestBillDiff(t *testing.T) {
+	observed := time.Date(2024, 6, 6, 9, 0, 0, 0, time.UTC)
+	old := []models.TVBPMBILL11Row{
+		{BillId: "PRC_A", BillNumber: "2200001", BillName: "가법 일부개정법률안", JurisdictionCommittee: "법제사법위원회"},
+		{BillId: "PRC_B", BillNumber: "2200002", BillName: "나법 일부개정법률안",
+			CommitteeProcessResult: strPtr("원안가결"), CommitteeProcessDate: strPtr("2024-05-01")},
+		{BillId: "PRC_C", BillNumber: "2200003", BillName: "다법 폐지법률안", PlenarySessionReviewResult: "원안가결"},
+	}
+	new := []models.TVBPMBILL11Row{
+		{BillId: "PRC_D", BillNumber: "2200004", BillName: "라법 제정안"},
+		{BillId: "PRC_A", BillNumber: "2200001", BillName: "가법 일부개정법률안", JurisdictionCommittee: "법제사법위원회",
+			CommitteeProcessResult: strPtr("수정가결"), CommitteeProcessDate: strPtr("2024-06-05")},
+		{BillId: "PRC_B", BillNumber: "2200002", BillName: "나법 일부개정법률안",
+			CommitteeProcessResult: strPtr("대안반영폐기"), CommitteeProcessDate: strPtr("2024-06-01"),
+			LegislationAndJudiciaryProcessResult: strPtr("")}, // 빈 문자열은 nil과 같음
+	}
+
+	t.Run("기본 비교", func(t *testing.T) {
+		d, err := billdiff.New(billdiff.WithClock(func() time.Time { return observed }))
+		if err != nil {
+			t.Fatal(err)
+		}
+		changes := d.Diff(old, new)
+
+		want := []string{
+			"[2200004] 라법 제정안: 새 의안",
+			"[2200001] 가법 일부개정법률안: 소관위 처리결과 수정가결 (2024-06-05)",
+			"[2200001] 가법 일부개정법률안: 소관위 처리일 2024-06-05",
+			"[2200002] 나법 일부개정법률안: 소관위 처리결과 원안가결 → 대안반영폐기 (2024-06-01)",
+			"[2200002] 나법 일부개정법률안: 소관위 처리일 2024-05-01 → 2024-06-01",
+			"[2200003] 다법 폐지법률안: 목록에서 사라짐",
+		}
+		if len(changes) != len(want) {
+			t.Fatalf("변경 수 기대값: %d, 결과값: %d (%v)", len(want), len(changes), changes)
+		}
+		for i, c := range changes {
+			if c.String() != want[i] {
+				t.Errorf("%d번째 기대값: %q, 결과값: %q", i, want[i], c.String())
+			}
+			if !c.ObservedAt.Equal(observed) {
+				t.Errorf("발견 시각 기대값: %v, 결과값: %v", observed, c.ObservedAt)
+			}
+		}
+
+		c := changes[1]
+		if c.Kind != billdiff.FieldSet || c.Stage != billdiff.StageCommittee || c.Field != "CMT_PROC_RESULT_CD" || c.Old != "" || c.New != "수정가결" {
+			t.Errorf("변경 기록이 올바르지 않습니다: %+v", c)
+		}
+		if changes[3].Kind != billdiff.FieldChanged {
+			t.Errorf("기대값: %s, 결과값: %s", billdiff.FieldChanged, changes[3].Kind)
+		}
+	})
+
+	t.Run("처리 결과만 비교", func(t *testing.T) {
+		d, err := billdiff.New(billdiff.WithFields(billdiff.StatusFields...), billdiff.WithMembership(false))
+		if err != nil {
+			t.Fatal(err)
+		}
+		changes := d.Diff(old, new)
+		if len(changes) != 2 || changes[0].BillID != "PRC_A" || changes[1].BillID != "PRC_B" {
+			t.Errorf("처리 결과 변경 2건을 기대했습니다: %v", changes)
+		}
+	})
+
+	t.Run("값이 비워짐", func(t *testing.T) {
+		prev := []models.TVBPMBILL11Row{{BillId: "PRC_A", PlenarySessionReviewResult: "원안가결", ResolutionDate: "2024-06-01"}}
+		cur := []models.TVBPMBILL11Row{{BillId: "PRC_A"}}
+		changes := billdiff.Diff(prev, cur)
+		if len(changes) != 2 || changes[0].Kind != billdiff.FieldCleared || changes[0].Stage != billdiff.StagePlenary {
+			t.Fatalf("비워진 필드 2건을 기대했습니다: %+v", changes)
+		}
+		if got, want := changes[0].String(), "[PRC_A]: 본회의 심의결과 삭제 (이전: 원안가결)"; got != want {
+			t.Errorf("기대값: %q, 결과값: %q", want, got)
+		}
+	})
+
+	t.Run("감사 기록용 JSON", func(t *testing.T) {
+		changes := billdiff.Diff(old[:1], new[1:2])
+		data, err := json.Marshal(changes[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		var decoded billdiff.Change
+		// time.Now의 단조 시계 값은 JSON에 남지 않으므로 시각은 Equal로 비교합니다.
+		want := changes[0]
+		if err := json.Unmarshal(data, &decoded); err != nil || !decoded.ObservedAt.Equal(want.ObservedAt) {
+			t.Fatalf("JSON 왕복 결과가 다릅니다: %s (%v)", data, err)
+		}
+		decoded.ObservedAt = want.ObservedAt
+		if decoded != want {
+			t.Errorf("JSON 왕복 결과가 다릅니다: %s", data)
+		}
+	})
+
+	t.Run("알 수 없는 필드", func(t *testing.T) {
+		if _, err := billdiff.New(billdiff.WithFields("NOPE")); !errors.Is(err, assembly_go.ErrInvalidConfig) {
+			t.Errorf("기대값: %v, 결과값: %v", assembly_go.ErrInvalidConfig, err)
+		}
+	})
+
+	t.Run("같은 스냅숏", func(t *testing.T) {
+		if changes := billdiff.Diff(old, old); len(changes) != 0 {
+			t.Errorf("변경이 없어야 합니다: %v", changes)
+		}
+	})
+}
@@ -0,0 +1,236 @@
+package assembly_go_test
+
+import (
+	"assembly_go"
+	"assembly_go/assemblytest"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+// newBulkFixture는 PDF를 내려주는 가짜 서버와 다운로드 디렉터리를 준비합니다.
+func newBulkFixture(t *testing.T) (*assemblytest.Server, *assembly_go.Client, string) {
+	t.Helper()
+	srv := assemblytest.NewServer()
+	t.Cleanup(srv.Close)
+	client, err := srv.NewClient(assembly_go.WithRetries(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return srv, client, t.TempDir()
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func TestBulkDownloader(t *testing.T) {
+	srv, client, dir := newBulkFixture(t)
+	billPDF := []byte("%PDF-1.4 bill")
+	recordPDF := []byte("%PDF-1.4 record")
+	srv.AddBillPDF("PRC_A", billPDF)
+	url1 := srv.AddFile("/records/1.pdf", recordPDF)
+	url2 := srv.AddFile("/records/2.pdf", recordPDF) // 같은 내용
+	jobs := []assembly_go.DownloadJob{
+		{BillID: "PRC_A", Path: "22/2200001.pdf"},
+		{BillID: "PRC_MISSING"}, // PDF 없음 → HTML 오류 페이지
+		{URL: url1, Path: "22/record-1.pdf"},
+		{URL: url2, Path: "22/record-2.pdf"},
+	}
+
+	var mu sync.Mutex
+	var progress []assembly_go.BulkResult
+	bulk, err := assembly_go.NewBulkDownloader(client, dir,
+		assembly_go.WithBulkConcurrency(1),
+		assembly_go.WithBulkProgress(func(r assembly_go.BulkResult) {
+			mu.Lock()
+			defer mu.Unlock()
+			progress = append(progress, r)
+		}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	summary, err := bulk.RunJobs(context.Background(), jobs)
+	if err != nil {
+		t.Fatalf("에러 발생: %v", err)
+	}
+	want := assembly_go.BulkSummary{Total: 4, Downloaded: 3, Failed: 1}
+	if summary != want {
+		t.Errorf("기대값: %+v, 결과값: %+v", want, summary)
+	}
+	if len(progress) != 4 {
+		t.Errorf("진행 상황 콜백 횟수 기대값: 4, 결과값: %d", len(progress))
+	}
+
+	t.Run("매니페스트 기록", func(t *testing.T) {
+		manifest, err := assembly_go.LoadManifest(filepath.Join(dir, "manifest.json"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		bill, ok := manifest.Get("bill:PRC_A")
+		if !ok || !bill.OK() {
+			t.Fatalf("PRC_A 기록이 없습니다: %+v", bill)
+		}
+		if bill.Path != "22/2200001.pdf" || bill.Size != int64(len(billPDF)) || bill.SHA256 != sha256Hex(billPDF) {
+			t.Errorf("기록이 올바르지 않습니다: %+v", bill)
+		}
+		if bill.ContentType != "application/pdf" || bill.FetchedAt.IsZero() {
+			t.Errorf("콘텐츠 타입 또는 시각이 없습니다: %+v", bill)
+		}
+		data, _ := os.ReadFile(filepath.Join(dir, "22", "2200001.pdf"))
+		if string(data) != string(billPDF) {
+			t.Errorf("저장된 파일 기대값: %q, 결과값: %q", billPDF, data)
+		}
+
+		missing, _ := manifest.Get("bill:PRC_MISSING")
+		if missing.OK() || missing.Error == "" || missing.Path != "bills/PRC_MISSING.pdf" {
+			t.Errorf("실패 기록이 올바르지 않습니다: %+v", missing)
+		}
+		if failures := manifest.Failures(); len(failures) != 1 {
+			t.Errorf("실패 목록 기대값: 1건, 결과값: %d건", len(failures))
+		}
+	})
+
+	t.Run("내용이 같아도 요청한 경로에 저장", func(t *testing.T) {
+		second, _ := bulk.Manifest().Get(url2)
+		if second.DuplicateOf != "" || second.Path != "22/record-2.pdf" {
+			t.Errorf("기본값에서는 중복 처리를 하지 않아야 합니다: %+v", second)
+		}
+		data, _ := os.ReadFile(filepath.Join(dir, "22", "record-2.pdf"))
+		if string(data) != string(recordPDF) {
+			t.Errorf("저장된 파일 기대값: %q, 결과값: %q", recordPDF, data)
+		}
+	})
+
+	t.Run("다시 실행하면 실패한 작업만 처리", func(t *testing.T) {
+		srv.AddBillPDF("PRC_MISSING", []byte("%PDF-1.4 late"))
+		before := len(srv.Requests())
+
+		resumed, err := assembly_go.NewBulkDownloader(client, dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		summary, err := resumed.RunJobs(context.Background(), jobs)
+		if err != nil {
+			t.Fatalf("에러 발생: %v", err)
+		}
+		want := assembly_go.BulkSummary{Total: 4, Downloaded: 1, Skipped: 3}
+		if summary != want {
+			t.Errorf("기대값: %+v, 결과값: %+v", want, summary)
+		}
+		if got := len(srv.Requests()) - before; got != 1 {
+			t.Errorf("요청 수 기대값: 1, 결과값: %d", got)
+		}
+		if failures := resumed.Manifest().Failures(); len(failures) != 0 {
+			t.Errorf("실패 기록이 남아 있습니다: %+v", failures)
+		}
+	})
+
+	t.Run("파일이 지워졌으면 다시 다운로드", func(t *testing.T) {
+		os.Remove(filepath.Join(dir, "22", "2200001.pdf"))
+		resumed, _ := assembly_go.NewBulkDownloader(client, dir)
+		summary, _ := resumed.RunJobs(context.Background(), jobs[:1])
+		if summary.Downloaded != 1 {
+			t.Errorf("기대값: 다운로드 1건, 결과값: %+v", summary)
+		}
+	})
+
+	t.Run("WithBulkRedownload", func(t *testing.T) {
+		redo, _ := assembly_go.NewBulkDownloader(client, dir, assembly_go.WithBulkRedownload())
+		summary, _ := redo.RunJobs(context.Background(), jobs[:1])
+		if summary.Downloaded != 1 || summary.Skipped != 0 {
+			t.Errorf("기대값: 다운로드 1건, 결과값: %+v", summary)
+		}
+	})
+}
+
+func TestBulkDownloaderAdoptExisting(t *testing.T) {
+	srv, client, dir := newBulkFixture(t)
+	srv.AddBillPDF("PRC_A", []byte("%PDF-server"))
+	existing := []byte("%PDF-already-archived")
+	os.MkdirAll(filepath.Join(dir, "bills"), 0o755)
+	os.WriteFile(filepath.Join(dir, "bills", "PRC_A.pdf"), existing, 0o644)
+
+	bulk, _ := assembly_go.NewBulkDownloader(client, dir, assembly_go.WithBulkAdoptExisting())
+	summary, err := bulk.RunJobs(context.Background(), []assembly_go.DownloadJob{{BillID: "PRC_A"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if summary.Skipped != 1 || srv.RequestCount(assemblytest.FileGatePath) != 0 {
+		t.Errorf("기존 파일을 받아들이고 요청하지 않아야 합니다: %+v", summary)
+	}
+	entry, _ := bulk.Manifest().Get("bill:PRC_A")
+	if entry.SHA256 != sha256Hex(existing) {
+		t.Errorf("기존 파일의 해시를 기록해야 합니다: %+v", entry)
+	}
+}
+
+func TestBulkDownloaderDedup(t *testing.T) {
+	srv, client, dir := newBulkFixture(t)
+	recordPDF := []byte("%PDF-1.4 record")
+	url1 := srv.AddFile("/records/1.pdf", recordPDF)
+	url2 := srv.AddFile("/records/2.pdf", recordPDF) // 같은 내용
+	jobs := []assembly_go.DownloadJob{
+		{URL: url1, Path: "22/record-1.pdf"},
+		{URL: url2, Path: "22/record-2.pdf"},
+	}
+
+	bulk, _ := assembly_go.NewBulkDownloader(client, dir,
+		assembly_go.WithBulkConcurrency(1), // 중복 판정 순서를 고정합니다.
+		assembly_go.WithBulkDedup(),
+	)
+	summary, err := bulk.RunJobs(context.Background(), jobs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := assembly_go.BulkSummary{Total: 2, Downloaded: 1, Duplicates: 1}
+	if summary != want {
+		t.Errorf("기대값: %+v, 결과값: %+v", want, summary)
+	}
+	dup, _ := bulk.Manifest().Get(url2)
+	if dup.DuplicateOf != url1 || dup.Path != "22/record-1.pdf" {
+		t.Errorf("중복 기록이 원본을 가리켜야 합니다: %+v", dup)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "22", "record-2.pdf")); !os.IsNotExist(err) {
+		t.Error("중복 파일을 따로 저장하지 않아야 합니다")
+	}
+}
+
+func TestBulkDownloaderErrors(t *testing.T) {
+	t.Run("저장 디렉터리 밖 경로", func(t *testing.T) {
+		_, client, dir := newBulkFixture(t)
+		bulk, _ := assembly_go.NewBulkDownloader(client, dir)
+		summary, _ := bulk.RunJobs(context.Background(), []assembly_go.DownloadJob{{BillID: "X", Path: "../escape.pdf"}})
+		entry, _ := bulk.Manifest().Get("bill:X")
+		if summary.Failed != 1 || entry.Error == "" {
+			t.Errorf("디렉터리 밖 경로는 실패로 기록해야 합니다: %+v %+v", summary, entry)
+		}
+	})
+
+	t.Run("취소된 컨텍스트", func(t *testing.T) {
+		_, client, dir := newBulkFixture(t)
+		bulk, _ := assembly_go.NewBulkDownloader(client, dir)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := bulk.RunJobs(ctx, []assembly_go.DownloadJob{{BillID: "X"}})
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("기대값: context.Canceled, 결과값: %v", err)
+		}
+	})
+
+	t.Run("손상된 매니페스트", func(t *testing.T) {
+		_, client, dir := newBulkFixture(t)
+		os.WriteFile(filepath.Join(dir, "manifest.json"), []byte("{"), 0o644)
+		if _, err := assembly_go.NewBulkDownloader(client, dir); err == nil {
+			t.Error("손상된 매니페스트에 대해 에러를 반환해야 합니다")
+		}
+	})
+}
@@ -0,0 +1,78 @@
+package assembly_go_test
+
+import (
+	"assembly_go"
+	"assembly_go/assemblytest"
+	"assembly_go/cassette"
+	"assembly_go/models"
+	"bytes"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCassetteRecordAndReplay(t *testing.T) {
+	dir := t.TempDir()
+	pdf := []byte("%PDF-1.4 \x00\x01 binary")
+
+	// 녹화: 가짜 서버에 실제로 요청하면서 카세트에 저장합니다.
+	srv := assemblytest.NewServer()
+	srv.AddBills(models.TVBPMBILL11Row{BillId: "B1", Age: "22"})
+	srv.AddBillPDF("BOOK1", pdf)
+	recorder := cassette.NewRecorder(dir, nil)
+	client, _ := assembly_go.NewClient("SECRET_KEY",
+		assembly_go.WithBaseURL(srv.URL),
+		assembly_go.WithHTTPClient(&http.Client{Transport: recorder}),
+	)
+	if _, err := client.FetchApiData("TVBPMBILL11", http.MethodGet, map[string]string{"AGE": "22", "pIndex": "1"}); err != nil {
+		t.Fatalf("녹화 중 에러 발생: %v", err)
+	}
+	if _, err := client.DownloadBill("BOOK1"); err != nil {
+		t.Fatalf("녹화 중 에러 발생: %v", err)
+	}
+	baseURL := srv.URL
+	srv.Close() // 재생은 네트워크 없이 동작해야 합니다.
+
+	files, _ := filepath.Glob(filepath.Join(dir, "*.json"))
+	if len(files) != 2 {
+		t.Fatalf("카세트 파일 2개를 기대했지만 %d개입니다.", len(files))
+	}
+	for _, f := range files {
+		data, _ := os.ReadFile(f)
+		if strings.Contains(string(data), "SECRET_KEY") {
+			t.Errorf("카세트 파일에 인증키가 남아 있습니다: %s", f)
+		}
+	}
+
+	// 재생: 다른 인증키, 다른 인자 순서로도 매칭되어야 합니다.
+	replayer, err := cassette.NewReplayer(dir)
+	if err != nil {
+		t.Fatalf("카세트 읽기 중 에러 발생: %v", err)
+	}
+	client, _ = assembly_go.NewClient("OTHER_KEY",
+		assembly_go.WithBaseURL(baseURL),
+		assembly_go.WithRetries(1),
+		assembly_go.WithHTTPClient(&http.Client{Transport: replayer}),
+	)
+	data, err := client.FetchApiData("TVBPMBILL11", http.MethodGet, map[string]string{"pIndex": "1", "AGE": "22"})
+	if err != nil || !strings.Contains(string(data), `"B1"`) {
+		t.Fatalf("재생된 응답이 올바르지 않습니다: %v, %s", err, data)
+	}
+	got, err := client.DownloadBill("BOOK1")
+	if err != nil || !bytes.Equal(got, pdf) {
+		t.Errorf("재생된 PDF가 원본과 다릅니다: %v", err)
+	}
+
+	t.Run("녹화되지 않은 요청은 ErrNoMatch", func(t *testing.T) {
+		_, err := client.FetchApiData("TVBPMBILL11", http.MethodGet, map[string]string{"AGE": "21"})
+		if !errors.Is(err, cassette.ErrNoMatch) {
+			t.Errorf("예상 에러: %v, 실제 에러: %v", cassette.ErrNoMatch, err)
+		}
+		if misses := replayer.Misses(); len(misses) != 1 || !strings.Contains(misses[0], "AGE=21") {
+			t.Errorf("매칭 실패 기록이 올바르지 않습니다: %v", misses)
+		}
+	})
+}
@@ -2,6 +2,7 @@ package assembly_go_test
 
 import (
 	"assembly_go"
+	"assembly_go/assemblytest"
 	"assembly_go/models"
 	"errors"
 	"fmt"
@@ -97,6 +98,59 @@ func TestStructToMapString(t *testing.T) {
 		}
 	})
 
+	t.Run("선택 인자 포인터 필드", func(t *testing.T) {
+		party := "더불어민주당"
+		params := models.NojepdqqaweusdfbiOptionalParams{POLY_NM: &party}
+		expected := map[string]string{"POLY_NM": "더불어민주당"}
+
+		result, err := assembly_go.StructToMapString(params)
+		if err != nil {
+			t.Fatalf("StructToMapString 변환 중 에러 발생: %v", err)
+		}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("nil 포인터는 제외하고 값은 역참조해야 합니다. 기대값: %v, 결과값: %v", expected, result)
+		}
+	})
+
+	t.Run("json 태그의 옵션은 키에서 제외", func(t *testing.T) {
+		type Tagged struct {
+			Name string  `json:"NAME,omitempty"`
+			Date *string `json:"CONF_DT,omitempty"`
+			Raw  string
+		}
+		date := "2024-06-05"
+		expected := map[string]string{"NAME": "x", "CONF_DT": date, "Raw": ""}
+
+		result, err := assembly_go.StructToMapString(Tagged{Name: "x", Date: &date})
+		if err != nil {
+			t.Fatalf("StructToMapString 변환 중 에러 발생: %v", err)
+		}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("기대값: %v, 결과값: %v", expected, result)
+		}
+	})
+
+	t.Run("요청 인자 모델의 json 태그", func(t *testing.T) {
+		// 태그가 잘못되면(예: json:BILL_ID) reflect가 빈 값을 돌려주어 필드 이름이 요청 인자로 나갑니다.
+		for _, params := range []any{
+			models.TVBPMBILL11RequestParams{}, models.TVBPMBILL11OptionalParams{},
+			models.AllNameMemberRequestParams{}, models.AllNameMemberOptionalParams{},
+			models.VCONFBILLCONFLISTRequestParams{}, models.VCONFBILLCONFLISTOptionalParams{},
+			models.VCONFPHCONFLISTRequestParams{}, models.VCONFPHCONFLISTOptionalParams{},
+			models.NojepdqqaweusdfbiRequestParams{}, models.NojepdqqaweusdfbiOptionalParams{},
+			models.NprlapfmaufmqytetRequestParams{}, models.NprlapfmaufmqytetOptionalParams{},
+			models.NwvrqwxyaytdsfvhuRequestParams{}, models.NwvrqwxyaytdsfvhuOptionalParams{},
+		} {
+			typ := reflect.TypeOf(params)
+			for i := range typ.NumField() {
+				field := typ.Field(i)
+				if _, ok := field.Tag.Lookup("json"); !ok {
+					t.Errorf("%s.%s의 json 태그를 읽을 수 없습니다: %s", typ.Name(), field.Name, field.Tag)
+				}
+			}
+		}
+	})
+
 	t.Run("비-구조체 입력에 대한 에러 처리", func(t *testing.T) {
 		_, err := assembly_go.StructToMapString("not a struct")
 		if err == nil {
@@ -105,6 +159,34 @@ func TestStructToMapString(t *testing.T) {
 	})
 }
 
+func TestFetchWithOptions(t *testing.T) {
+	srv := assemblytest.NewServer()
+	defer srv.Close()
+	srv.AddVotes(
+		models.NojepdqqaweusdfbiRow{MemberName: "홍길동", BillID: "B1", Age: "22", VoteResult: "찬성"},
+		models.NojepdqqaweusdfbiRow{MemberName: "김철수", BillID: "B1", Age: "22", VoteResult: "반대"},
+	)
+	client, _ := srv.NewClient()
+
+	result := "반대"
+	resp, err := client.FetchMemberVoteResultWithOptions(
+		models.NojepdqqaweusdfbiRequestParams{Pindex: "1", Psize: "10", AGE: "22", BILL_ID: "B1"},
+		models.NojepdqqaweusdfbiOptionalParams{RESULT_VOTE_MOD: &result},
+	)
+	if err != nil {
+		t.Fatalf("에러 발생: %v", err)
+	}
+	rows := resp.AllRows()
+	if len(rows) != 1 || rows[0].MemberName != "김철수" {
+		t.Errorf("기대값: 김철수 1건, 결과값: %+v", rows)
+	}
+
+	query := srv.Requests()[0].Query
+	if query.Has("HG_NM") || query.Get("RESULT_VOTE_MOD") != "반대" {
+		t.Errorf("지정한 선택 인자만 보내야 합니다: %v", query)
+	}
+}
+
 func TestFetchApiData(t *testing.T) {
 	t.Run("API 데이터 가져오기 성공", func(t *testing.T) {
 		expectedBody := `{"status": "ok"}`
@@ -0,0 +1,101 @@
+package assembly_go_test
+
+import (
+	"assembly_go/committee"
+	"assembly_go/models"
+	"slices"
+	"testing"
+)
+
+func committeeNames(cs []committee.Committee) []string {
+	var names []string
+	for _, c := range cs {
+		names = append(names, c.Name)
+	}
+	return names
+}
+
+func TestCommittee(t *testing.T) {
+	t.Run("이름, 약칭, 옛 이름", func(t *testing.T) {
+		reg := committee.NewRegistry()
+		for _, name := range []string{"국토교통위원회", "국토위", "국토해양위원회", " 국토 교통 위원회 "} {
+			c, ok := reg.Lookup(name)
+			if !ok || c.Name != "국토교통위원회" || c.Kind != committee.KindStanding {
+				t.Errorf("%q 결과값: %+v (%v)", name, c, ok)
+			}
+		}
+		if _, ok := reg.Lookup("없는위원회"); ok {
+			t.Error("없는 위원회는 false여야 합니다")
+		}
+		for _, name := range []string{"교육과학기술위원회", "문화체육관광방송통신위원회"} {
+			if c, ok := reg.Lookup(name); ok {
+				t.Errorf("둘로 나뉜 옛 위원회 %q는 한쪽으로 정하지 않아야 합니다: %+v", name, c)
+			}
+		}
+	})
+
+	t.Run("코드와 이름 변경 학습", func(t *testing.T) {
+		reg := committee.NewRegistry()
+		bills := []models.TVBPMBILL11Row{
+			{BillId: "A", JurisdictionCommitteeCode: "9700008", JurisdictionCommittee: "국토교통위원회"},
+			{BillId: "B", JurisdictionCommitteeCode: "9700008", JurisdictionCommittee: "국토해양관광위원회"}, // 내장 목록에 없는 옛 이름
+			{BillId: "C", JurisdictionCommitteeCode: "9700006", JurisdictionCommittee: "법제사법위원회"},
+			{BillId: "D"}, // 소관위 미정
+		}
+		reg.ObserveBills(bills...)
+
+		c, ok := reg.Lookup("9700008")
+		if !ok || c.Name != "국토교통위원회" || !slices.Contains(c.Codes, "9700008") || !slices.Contains(c.Aliases, "국토해양관광위원회") {
+			t.Errorf("결과값: %+v (%v)", c, ok)
+		}
+		var ids []string
+		for _, row := range reg.FilterBills(bills, c) {
+			ids = append(ids, row.BillId)
+		}
+		if want := []string{"A", "B"}; !slices.Equal(ids, want) {
+			t.Errorf("기대값: %v, 결과값: %v", want, ids)
+		}
+
+		// 코드만 같고 이름이 다른 내장 위원회를 가리키면 이름을 가져오지 않습니다.
+		reg.Observe("9700006", "국토교통위원회")
+		if c, _ := reg.Lookup("국토교통위원회"); c.Name != "국토교통위원회" {
+			t.Errorf("다른 위원회의 이름을 가져가면 안 됩니다: %+v", c)
+		}
+	})
+
+	t.Run("처음 보는 위원회와 회의록 필터", func(t *testing.T) {
+		reg := committee.NewRegistry()
+		meetings := []models.VCONFPHCONFLISTRow{
+			{CONF_ID: "1", CMIT_CD: "9701000", CMIT_NM: "인구위기특별위원회"},
+			{CONF_ID: "2", CMIT_NM: "본회의"},
+			{CONF_ID: "3", CMIT_CD: "9701000", CMIT_NM: "인구위기 특별위원회"},
+		}
+		special, ok := reg.Meeting(meetings[0])
+		if !ok || special.Kind != committee.KindOther {
+			t.Fatalf("결과값: %+v (%v)", special, ok)
+		}
+		got := reg.FilterMeetings(meetings, special)
+		if len(got) != 2 || got[1].CONF_ID != "3" {
+			t.Errorf("결과값: %+v", got)
+		}
+		if _, ok := reg.Observe("9999999", ""); ok {
+			t.Error("처음 보는 코드만으로는 위원회를 만들지 않아야 합니다")
+		}
+	})
+
+	t.Run("위원회 목록", func(t *testing.T) {
+		if got := committee.ParseList(" 법사위, ,정무위원회 ,"); !slices.Equal(got, []string{"법사위", "정무위원회"}) {
+			t.Errorf("결과값: %q", got)
+		}
+		reg := committee.NewRegistry()
+		member := models.NwvrqwxyaytdsfvhuRow{CmitNm: strPtr("법제사법위원회"), Cmits: strPtr("법제사법위원회, 정보위원회, 지식경제위원회")}
+		want := []string{"법제사법위원회", "정보위원회", "산업통상자원중소벤처기업위원회"}
+		if got := committeeNames(reg.Member(member)); !slices.Equal(got, want) {
+			t.Errorf("기대값: %v, 결과값: %v", want, got)
+		}
+		all := models.AllNameMemberRow{CmitNm: "예결위", BlngCmitNm: "예산결산특별위원회,국방위원회"}
+		if got := committeeNames(reg.AllNameMember(all)); !slices.Equal(got, []string{"예산결산특별위원회", "국방위원회"}) {
+			t.Errorf("결과값: %v", got)
+		}
+	})
+}
@@ -0,0 +1,130 @@
+package assembly_go_test
+
+import (
+	"assembly_go"
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+// Client는 기존 Downloader와 조건부 다운로드용 ConditionalDownloader를 모두 구현해야 합니다.
+var (
+	_ assembly_go.Downloader            = (*assembly_go.Client)(nil)
+	_ assembly_go.ConditionalDownloader = (*assembly_go.Client)(nil)
+)
+
+// etagHandler는 ETag 기반 조건부 요청을 처리하는 모의 핸들러를 생성합니다.
+// 요청 수와 마지막으로 받은 If-None-Match 값을 기록합니다.
+func etagHandler(body []byte, etag string, hits *int, lastIfNoneMatch *string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		*hits++
+		*lastIfNoneMatch = r.Header.Get("If-None-Match")
+		if *lastIfNoneMatch == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Last-Modified", "Wed, 01 Jan 2025 00:00:00 GMT")
+		w.Write(body)
+	}
+}
+
+func TestDownloadBillIfModified(t *testing.T) {
+	t.Run("두 번째 요청은 NotModified를 반환", func(t *testing.T) {
+		pdf := []byte("%PDF-1.4 bill")
+		var hits int
+		var ifNoneMatch string
+		server, client := setupTestClient(etagHandler(pdf, `"v1"`, &hits, &ifNoneMatch))
+		defer server.Close()
+
+		first, err := client.DownloadBillIfModified("bill-1")
+		if err != nil {
+			t.Fatalf("첫 다운로드 중 에러 발생: %v", err)
+		}
+		if first.NotModified || !bytes.Equal(first.Data, pdf) {
+			t.Fatalf("첫 다운로드는 전체 내용을 받아야 합니다: %+v", first)
+		}
+		if first.Validators.ETag != `"v1"` {
+			t.Errorf("ETag가 저장되지 않았습니다: %q", first.Validators.ETag)
+		}
+
+		second, err := client.DownloadBillIfModified("bill-1")
+		if err != nil {
+			t.Fatalf("두 번째 다운로드 중 에러 발생: %v", err)
+		}
+		if ifNoneMatch != `"v1"` {
+			t.Errorf("If-None-Match 헤더가 전송되지 않았습니다: %q", ifNoneMatch)
+		}
+		if !second.NotModified || second.Data != nil {
+			t.Errorf("두 번째 다운로드는 NotModified여야 합니다: %+v", second)
+		}
+	})
+
+	t.Run("HTML 응답은 검증자를 저장하지 않음", func(t *testing.T) {
+		var ifNoneMatch string
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			ifNoneMatch = r.Header.Get("If-None-Match")
+			w.Header().Set("ETag", `"error-page"`)
+			w.Write([]byte("<!DOCTYPE html><html></html>"))
+		}
+		server, client := setupTestClient(handler)
+		defer server.Close()
+
+		if _, err := client.DownloadBillIfModified("bill-1"); !errors.Is(err, assembly_go.ErrHTMLContent) {
+			t.Fatalf("예상 에러: %v, 실제 에러: %v", assembly_go.ErrHTMLContent, err)
+		}
+		client.DownloadBillIfModified("bill-1")
+		if ifNoneMatch != "" {
+			t.Errorf("HTML 응답의 ETag가 저장되었습니다: %q", ifNoneMatch)
+		}
+	})
+}
+
+func TestDownloadMeetingRecordIfModified(t *testing.T) {
+	t.Run("파일 저장소로 실행 간 검증자 유지", func(t *testing.T) {
+		pdf := []byte("%PDF-1.4 meeting")
+		var hits int
+		var ifNoneMatch string
+		server := httptest.NewServer(etagHandler(pdf, `"m1"`, &hits, &ifNoneMatch))
+		defer server.Close()
+		pdfURL := server.URL + "/record.pdf"
+		storePath := filepath.Join(t.TempDir(), "validators.json")
+
+		// 첫 번째 실행
+		store, err := assembly_go.NewFileValidatorStore(storePath)
+		if err != nil {
+			t.Fatalf("저장소 생성 중 에러 발생: %v", err)
+		}
+		client, _ := assembly_go.NewClient("TEST_API_KEY", assembly_go.WithValidatorStore(store))
+		if _, err := client.DownloadMeetingRecordIfModified(pdfURL); err != nil {
+			t.Fatalf("첫 다운로드 중 에러 발생: %v", err)
+		}
+
+		// 두 번째 실행: 새 저장소와 클라이언트가 같은 파일을 읽어야 합니다.
+		store, err = assembly_go.NewFileValidatorStore(storePath)
+		if err != nil {
+			t.Fatalf("저장소 재생성 중 에러 발생: %v", err)
+		}
+		client, _ = assembly_go.NewClient("TEST_API_KEY", assembly_go.WithValidatorStore(store))
+		result, err := client.DownloadMeetingRecordIfModified(pdfURL)
+		if err != nil {
+			t.Fatalf("두 번째 다운로드 중 에러 발생: %v", err)
+		}
+		if !result.NotModified {
+			t.Errorf("저장된 검증자로 NotModified를 받아야 합니다: %+v", result)
+		}
+		if hits != 2 {
+			t.Errorf("요청 수 기대값: 2, 결과값: %d", hits)
+		}
+	})
+
+	t.Run("빈 URL로 인한 에러", func(t *testing.T) {
+		client, _ := assembly_go.NewClient("TEST_API_KEY")
+		if _, err := client.DownloadMeetingRecordIfModified(""); !errors.Is(err, assembly_go.ErrInvalidID) {
+			t.Errorf("예상 에러: %v, 실제 에러: %v", assembly_go.ErrInvalidID, err)
+		}
+	})
+}
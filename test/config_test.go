@@ -0,0 +1,180 @@
+package assembly_go_test
+
+import (
+	"assembly_go"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLoadConfig(t *testing.T) {
+	dir := t.TempDir()
+	expected := &assembly_go.Config{
+		APIKeys:   []string{"K1", "K2"},
+		BaseURL:   "https://example.com/openapi",
+		Timeout:   45 * time.Second,
+		Retries:   5,
+		RateLimit: 2.5,
+		CacheDir:  "/tmp/assembly",
+		UserAgent: "archiver/1.0",
+	}
+
+	testCases := []struct {
+		name    string
+		file    string
+		content string
+	}{
+		{"env 파일", "assembly.env", `
+# 주석
+APIKEY="K1"
+ASSEMBLY_API_KEYS=K2
+ASSEMBLY_BASE_URL=https://example.com/openapi/
+ASSEMBLY_TIMEOUT=45s
+ASSEMBLY_RETRIES=5
+ASSEMBLY_RATE_LIMIT=2.5
+ASSEMBLY_CACHE_DIR=/tmp/assembly
+export ASSEMBLY_USER_AGENT='archiver/1.0'
+OTHER_TOOL_SETTING=ignored
+`},
+		{"JSON 파일", "assembly.json", `{
+  "api_keys": ["K1", "K2"],
+  "base_url": "https://example.com/openapi",
+  "timeout": 45,
+  "retries": 5,
+  "rate_limit": 2.5,
+  "cache_dir": "/tmp/assembly",
+  "user_agent": "archiver/1.0"
+}`},
+		{"TOML 파일", "assembly.toml", `
+[assembly]
+api_keys = ["K1", "K2"] 
+base_url = "https://example.com/openapi"
+timeout = "45s"
+retries = 5
+rate_limit = 2.5
+cache_dir = "/tmp/assembly"
+user_agent = "archiver/1.0"
+`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(dir, tc.file)
+			os.WriteFile(path, []byte(tc.content), 0o600)
+
+			cfg, err := assembly_go.LoadConfig(path)
+			if err != nil {
+				t.Fatalf("설정 읽기 중 에러 발생: %v", err)
+			}
+			if !reflect.DeepEqual(cfg, expected) {
+				t.Errorf("기대값: %+v, 결과값: %+v", expected, cfg)
+			}
+		})
+	}
+
+	t.Run("빠지거나 잘못된 항목을 모두 나열", func(t *testing.T) {
+		path := filepath.Join(dir, "bad.json")
+		os.WriteFile(path, []byte(`{"base_url": "not-a-url", "timeout": "soon", "retries": 0}`), 0o600)
+
+		_, err := assembly_go.LoadConfig(path)
+		if !errors.Is(err, assembly_go.ErrInvalidConfig) {
+			t.Fatalf("예상 에러: %v, 실제 에러: %v", assembly_go.ErrInvalidConfig, err)
+		}
+		var cfgErr *assembly_go.ConfigError
+		if !errors.As(err, &cfgErr) {
+			t.Fatalf("ConfigError가 아닙니다: %v", err)
+		}
+		if len(cfgErr.Missing) != 1 || len(cfgErr.Invalid) != 3 {
+			t.Errorf("누락 1개, 오류 3개를 기대했지만: %+v", cfgErr)
+		}
+		for _, name := range []string{"api_key", "base_url", "timeout", "retries"} {
+			if !strings.Contains(err.Error(), name) {
+				t.Errorf("에러 메시지에 %s 항목이 없습니다: %v", name, err)
+			}
+		}
+	})
+
+	t.Run("알 수 없는 항목", func(t *testing.T) {
+		for file, content := range map[string]string{
+			"unknown.json": `{"api_key": "K1", "timout": 30}`,
+			"unknown.toml": "api_key = \"K1\"\ntimout = 30\n",
+			"unknown.env":  "APIKEY=K1\nASSEMBLY_TIMOUT=30\n",
+		} {
+			path := filepath.Join(dir, file)
+			os.WriteFile(path, []byte(content), 0o600)
+			_, err := assembly_go.LoadConfig(path)
+			if !errors.Is(err, assembly_go.ErrInvalidConfig) || !strings.Contains(err.Error(), "timout") {
+				t.Errorf("%s: 알 수 없는 항목을 ErrInvalidConfig로 보고해야 합니다: %v", file, err)
+			}
+		}
+	})
+}
+
+func TestNewClientFromEnv(t *testing.T) {
+	var gotKey, gotUA string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotKey = r.URL.Query().Get("KEY")
+		gotUA = r.Header.Get("User-Agent")
+		fmt.Fprint(w, `{}`)
+	}))
+	defer server.Close()
+
+	t.Chdir(t.TempDir()) // 작업 디렉터리의 .env 파일을 읽지 않도록 격리
+	t.Setenv("APIKEY", "ENV_KEY")
+	t.Setenv("ASSEMBLY_BASE_URL", server.URL)
+	t.Setenv("ASSEMBLY_USER_AGENT", "env-agent")
+
+	client, err := assembly_go.NewClientFromEnv()
+	if err != nil {
+		t.Fatalf("클라이언트 생성 시 에러 발생: %v", err)
+	}
+	if _, err := client.FetchApiData("TVBPMBILL11", http.MethodGet, nil); err != nil {
+		t.Fatalf("요청 중 에러 발생: %v", err)
+	}
+	if gotKey != "ENV_KEY" || gotUA != "env-agent" {
+		t.Errorf("환경 변수 설정이 적용되지 않았습니다: KEY=%q, User-Agent=%q", gotKey, gotUA)
+	}
+
+	t.Run(".env 파일 읽기", func(t *testing.T) {
+		t.Setenv("APIKEY", "")
+		os.Unsetenv("APIKEY")
+		os.WriteFile(".env", []byte("APIKEY=\"DOTENV_KEY\"\n"), 0o600)
+
+		client, err := assembly_go.NewClientFromEnv()
+		if err != nil {
+			t.Fatalf("클라이언트 생성 시 에러 발생: %v", err)
+		}
+		client.FetchApiData("TVBPMBILL11", http.MethodGet, nil)
+		if gotKey != "DOTENV_KEY" {
+			t.Errorf(".env의 APIKEY가 적용되지 않았습니다: %q", gotKey)
+		}
+	})
+
+	t.Run("환경 변수가 .env보다 우선", func(t *testing.T) {
+		os.WriteFile(".env", []byte("APIKEY=DOTENV_KEY\nASSEMBLY_API_KEY=DOTENV_ASSEMBLY_KEY\n"), 0o600)
+		t.Setenv("APIKEY", "ENV_KEY")
+
+		client, err := assembly_go.NewClientFromEnv()
+		if err != nil {
+			t.Fatalf("클라이언트 생성 시 에러 발생: %v", err)
+		}
+		client.FetchApiData("TVBPMBILL11", http.MethodGet, nil)
+		if gotKey != "ENV_KEY" {
+			t.Errorf("기대값: ENV_KEY, 결과값: %q", gotKey)
+		}
+
+		t.Setenv("ASSEMBLY_API_KEY", "ENV_ASSEMBLY_KEY")
+		client, _ = assembly_go.NewClientFromEnv()
+		client.FetchApiData("TVBPMBILL11", http.MethodGet, nil)
+		if gotKey != "ENV_ASSEMBLY_KEY" {
+			t.Errorf("ASSEMBLY_API_KEY가 APIKEY보다 우선해야 합니다: %q", gotKey)
+		}
+	})
+}
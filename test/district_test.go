@@ -0,0 +1,87 @@
+package assembly_go_test
+
+import (
+	"assembly_go/district"
+	"assembly_go/models"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestDistrict(t *testing.T) {
+	t.Run("선거구명 해석", func(t *testing.T) {
+		cases := []struct {
+			raw          string
+			province     string
+			municipality string
+			number       int
+			codes        []string
+		}{
+			{"서울 종로구", "서울", "종로구", 0, []string{"11110"}},
+			{"경기 수원시갑", "경기", "수원시", 1, []string{"41110"}},
+			{"강원 춘천시철원군화천군양구군을", "강원", "춘천시철원군화천군양구군", 2, []string{"51110", "51780", "51790", "51800"}},
+			{"경기 성남시분당구병", "경기", "성남시분당구", 3, []string{"41130"}},
+			{"경기 군포시", "경기", "군포시", 0, []string{"41410"}},
+			{"세종특별자치시갑", "세종", "", 1, []string{"36110"}},
+			{"강원도 원주시 갑", "강원", "원주시", 1, []string{"51130"}},
+			{"부산광역시 중구영도구", "부산", "중구영도구", 0, []string{"26110", "26200"}},
+		}
+		for _, c := range cases {
+			d, err := district.Parse(c.raw)
+			if err != nil {
+				t.Errorf("%q: %v", c.raw, err)
+				continue
+			}
+			if d.Province != c.province || d.Municipality != c.municipality || d.Number != c.number || !slices.Equal(d.RegionCodes, c.codes) {
+				t.Errorf("%q 결과값: %+v", c.raw, d)
+			}
+		}
+		d, _ := district.Parse("강원도 원주시 갑")
+		if d.Name() != "강원 원주시갑" || d.Suffix() != "갑" || d.ProvinceCode != "51" {
+			t.Errorf("결과값: %q, %q, %q", d.Name(), d.Suffix(), d.ProvinceCode)
+		}
+	})
+
+	t.Run("비례대표와 알 수 없는 선거구", func(t *testing.T) {
+		for _, raw := range []string{"비례대표", " 전국구 "} {
+			if d, err := district.Parse(raw); err != nil || !d.IsProportional || d.Name() != "비례대표" {
+				t.Errorf("%q 결과값: %+v (%v)", raw, d, err)
+			}
+		}
+		d, err := district.Parse("어딘가 무슨구을")
+		if !errors.Is(err, district.ErrUnknownDistrict) || d.Municipality != "어딘가무슨구" || d.Number != 2 {
+			t.Errorf("결과값: %+v (%v)", d, err)
+		}
+		if _, err := district.Parse(""); !errors.Is(err, district.ErrUnknownDistrict) {
+			t.Errorf("빈 선거구명은 ErrUnknownDistrict를 기대했지만 결과값: %v", err)
+		}
+		row := models.AllNameMemberRow{ElecdNm: "서울 종로구", ElecdDivNm: "비례대표"}
+		if d, _ := district.FromAllNameMember(row); !d.IsProportional {
+			t.Errorf("선거구 구분이 비례대표면 비례대표여야 합니다: %+v", d)
+		}
+	})
+
+	t.Run("선거구 코드 색인", func(t *testing.T) {
+		idx := district.NewIndex()
+		idx.ObserveVotes(
+			models.NojepdqqaweusdfbiRow{ConstituencyCode: "2410101", Constituency: "경기 수원시갑"},
+			models.NojepdqqaweusdfbiRow{ConstituencyCode: "", Constituency: "서울 종로구"},
+			models.NojepdqqaweusdfbiRow{ConstituencyCode: "9999", Constituency: ""},
+		)
+		if idx.Len() != 1 {
+			t.Errorf("코드 수 기대값: 1, 결과값: %d", idx.Len())
+		}
+		if d, ok := idx.Lookup(" 2410101 "); !ok || d.Name() != "경기 수원시갑" {
+			t.Errorf("결과값: %+v (%v)", d, ok)
+		}
+	})
+
+	t.Run("행정구역 코드", func(t *testing.T) {
+		if r, ok := district.RegionByCode("47940"); !ok || r.Name != "울릉군" || r.Province != "경북" {
+			t.Errorf("결과값: %+v (%v)", r, ok)
+		}
+		if len(district.Provinces()) != 17 || len(district.Municipalities("서울특별시")) != 25 {
+			t.Errorf("시도 %d개, 서울 자치구 %d개", len(district.Provinces()), len(district.Municipalities("서울")))
+		}
+	})
+}
@@ -105,7 +105,7 @@ func TestDownloadPdfWithUrl(t *testing.T) {
 		defer server.Close()
 
 		pdfUrl := fmt.Sprintf("%s/test.pdf", server.URL)
-		pdfData, err := client.DownloadBill(pdfUrl)
+		pdfData, err := client.DownloadMeetingRecord(pdfUrl)
 		if err != nil {
 			t.Fatalf("다운로드 중 에러 발생: %v", err)
 		}
@@ -117,7 +117,7 @@ func TestDownloadPdfWithUrl(t *testing.T) {
 
 	t.Run("빈 URL로 인한 에러", func(t *testing.T) {
 		client, _ := assembly_go.NewClient("TEST_API_KEY")
-		_, err := client.DownloadBill("")
+		_, err := client.DownloadMeetingRecord("")
 		if !errors.Is(err, assembly_go.ErrInvalidID) {
 			t.Errorf("예상 에러: %v, 실제 에러: %v", assembly_go.ErrInvalidID, err)
 		}
@@ -130,7 +130,7 @@ func TestDownloadPdfWithUrl(t *testing.T) {
 		server, client := setupTestClient(handler)
 		defer server.Close()
 
-		_, err := client.DownloadBill(server.URL + "/any.pdf")
+		_, err := client.DownloadMeetingRecord(server.URL + "/any.pdf")
 		if err == nil {
 			t.Fatal("에러가 발생해야 했지만, 발생하지 않았습니다.")
 		}
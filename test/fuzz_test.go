@@ -0,0 +1,121 @@
+package assembly_go_test
+
+import (
+	"assembly_go"
+	"assembly_go/models"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+)
+
+// 퍼즈 타깃은 시드(골든 픽스처)만으로 go test에서 항상 실행되며, 더 깊게 돌리려면 -fuzz를 사용합니다.
+//
+//	go test ./test -run '^$' -fuzz FuzzDecodeBills -fuzztime 30s
+
+// addFixtureSeeds는 endpoint의 모든 골든 픽스처를 퍼즈 시드로 추가합니다.
+func addFixtureSeeds(f *testing.F, endpoint string) {
+	f.Helper()
+	fixtures, err := filepath.Glob(filepath.Join(goldenDir, endpoint, "*.json"))
+	if err != nil {
+		f.Fatal(err)
+	}
+	for _, fixture := range fixtures {
+		body, err := os.ReadFile(fixture)
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(body)
+	}
+	f.Add([]byte(`{}`))
+	f.Add([]byte(`null`))
+	f.Add([]byte(`{"` + endpoint + `":[{"head":null},{"row":[null]}]}`))
+}
+
+// fuzzDecoder는 임의의 응답 본문에 대해 디코더가 패닉 없이 결과나 에러를 돌려주는지 확인합니다.
+// 성공한 결과는 다시 JSON으로 직렬화할 수 있어야 하고, TotalCount도 패닉 없이 호출되어야 합니다.
+func fuzzDecoder(f *testing.F, endpoint string) {
+	addFixtureSeeds(f, endpoint)
+	decode := decoders[endpoint]
+	f.Fuzz(func(t *testing.T, body []byte) {
+		resp, err := decode(fixtureClient(t, body))
+		if err != nil {
+			return
+		}
+		if _, err := json.Marshal(resp); err != nil {
+			t.Errorf("디코딩한 응답을 다시 직렬화할 수 없습니다: %v", err)
+		}
+		resp.(interface{ TotalCount() int }).TotalCount()
+	})
+}
+
+func FuzzDecodeBills(f *testing.F)             { fuzzDecoder(f, "TVBPMBILL11") }
+func FuzzDecodeAllMembers(f *testing.F)        { fuzzDecoder(f, "ALLNAMEMBER") }
+func FuzzDecodeBillConferences(f *testing.F)   { fuzzDecoder(f, "VCONFBILLCONFLIST") }
+func FuzzDecodeConferences(f *testing.F)       { fuzzDecoder(f, "VCONFPHCONFLIST") }
+func FuzzDecodeVotes(f *testing.F)             { fuzzDecoder(f, "nojepdqqaweusdfbi") }
+func FuzzDecodeHistoricalMembers(f *testing.F) { fuzzDecoder(f, "nprlapfmaufmqytet") }
+func FuzzDecodeMemberDetails(f *testing.F)     { fuzzDecoder(f, "nwvrqwxyaytdsfvhu") }
+
+// FuzzQueryEncoding은 요청 인자가 어떤 문자열이든 쿼리로 인코딩된 뒤 서버에서 그대로 복원되는지 확인합니다.
+func FuzzQueryEncoding(f *testing.F) {
+	f.Add("22", "PRC_A1B2", "1", "100")
+	f.Add("제22대", "a&b=c", "", "%20")
+	f.Add("홍길동 의원", "?#/", "-1", "1e3")
+	f.Add("\x00\xff", "+ +", "0", "")
+	f.Fuzz(func(t *testing.T, age, billID, pIndex, pSize string) {
+		var received *http.Request
+		transport := roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			received = r
+			return nil, errors.New("stop")
+		})
+		client, err := assembly_go.NewClient("TEST_KEY",
+			assembly_go.WithBaseURL("http://fixture.invalid"),
+			assembly_go.WithHTTPClient(&http.Client{Transport: transport}),
+			assembly_go.WithoutTermNormalization(), // 대수 표기 변환 없이 인코딩만 확인합니다.
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		client.FetchMemberVoteResult(models.NojepdqqaweusdfbiRequestParams{
+			AGE: age, BILL_ID: billID, Pindex: pIndex, Psize: pSize,
+		})
+		if received == nil {
+			t.Fatal("요청이 전송되지 않았습니다")
+		}
+
+		query := received.URL.Query()
+		want := map[string]string{"AGE": age, "BILL_ID": billID, "pIndex": pIndex, "pSize": pSize, "KEY": "TEST_KEY", "Type": "json"}
+		for name, value := range want {
+			if got := query.Get(name); got != value {
+				t.Errorf("%s 기대값: %q, 결과값: %q", name, value, got)
+			}
+		}
+	})
+}
+
+// FuzzStructToMapString은 임의의 필드 값이 손실 없이 요청 인자 맵으로 변환되는지 확인합니다.
+func FuzzStructToMapString(f *testing.F) {
+	f.Add("PRC_A", 1, 100)
+	f.Add("", 0, -1)
+	f.Add("의안\n\x00", -2147483648, 2147483647)
+	f.Fuzz(func(t *testing.T, billID string, pIndex, pSize int) {
+		params := models.VCONFBILLCONFLISTRequestParams{BILL_ID: billID, Pindex: pIndex, Psize: pSize}
+		m, err := assembly_go.StructToMapString(params)
+		if err != nil {
+			t.Fatalf("에러 발생: %v", err)
+		}
+		want := map[string]string{"BILL_ID": billID, "pIndex": strconv.Itoa(pIndex), "pSize": strconv.Itoa(pSize), "KEY": "", "Type": ""}
+		if len(m) != len(want) {
+			t.Errorf("인자 수 기대값: %d, 결과값: %d (%v)", len(want), len(m), m)
+		}
+		for name, value := range want {
+			if got, ok := m[name]; !ok || got != value {
+				t.Errorf("%s 기대값: %q, 결과값: %q", name, value, got)
+			}
+		}
+	})
+}
@@ -0,0 +1,154 @@
+package assembly_go_test
+
+import (
+	"assembly_go"
+	"assembly_go/models"
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// update가 설정되면 골든 파일을 현재 디코딩 결과로 다시 씁니다.
+//
+//	go test ./test -run TestDecoderGolden -update
+var update = flag.Bool("update", false, "골든 파일을 현재 결과로 갱신합니다")
+
+// goldenDir는 엔드포인트별 픽스처(<case>.json)와 기대 결과(<case>.golden)가 있는 디렉터리입니다.
+const goldenDir = "testdata/golden"
+
+// decoder는 픽스처 응답을 실제 Fetch 메서드로 디코딩합니다.
+type decoder func(c *assembly_go.Client) (any, error)
+
+// decoders는 엔드포인트 이름(픽스처 디렉터리 이름)별 Fetch 메서드입니다.
+var decoders = map[string]decoder{
+	"TVBPMBILL11": func(c *assembly_go.Client) (any, error) {
+		return c.FetchBills(models.TVBPMBILL11RequestParams{})
+	},
+	"ALLNAMEMBER": func(c *assembly_go.Client) (any, error) {
+		return c.FetchAllMembers(models.AllNameMemberRequestParams{})
+	},
+	"VCONFBILLCONFLIST": func(c *assembly_go.Client) (any, error) {
+		return c.FetchBillConferenceList(models.VCONFBILLCONFLISTRequestParams{})
+	},
+	"VCONFPHCONFLIST": func(c *assembly_go.Client) (any, error) {
+		return c.FetchMeetingConferenceList(models.VCONFPHCONFLISTRequestParams{})
+	},
+	"nojepdqqaweusdfbi": func(c *assembly_go.Client) (any, error) {
+		return c.FetchMemberVoteResult(models.NojepdqqaweusdfbiRequestParams{})
+	},
+	"nprlapfmaufmqytet": func(c *assembly_go.Client) (any, error) {
+		return c.FetchHistoricalMembers(models.NprlapfmaufmqytetRequestParams{})
+	},
+	"nwvrqwxyaytdsfvhu": func(c *assembly_go.Client) (any, error) {
+		return c.FetchMemberDetails(models.NwvrqwxyaytdsfvhuRequestParams{})
+	},
+}
+
+// fixtureClient는 어떤 요청에도 body를 200으로 돌려주는 클라이언트를 생성합니다.
+func fixtureClient(t testing.TB, body []byte) *assembly_go.Client {
+	t.Helper()
+	transport := roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": {"application/json;charset=UTF-8"}},
+			Body:       io.NopCloser(bytes.NewReader(body)),
+			Request:    r,
+		}, nil
+	})
+	client, err := assembly_go.NewClient("TEST_KEY",
+		assembly_go.WithBaseURL("http://fixture.invalid"),
+		assembly_go.WithHTTPClient(&http.Client{Transport: transport}),
+		assembly_go.WithRetries(1),
+	)
+	if err != nil {
+		t.Fatalf("클라이언트 생성 실패: %v", err)
+	}
+	return client
+}
+
+// render는 디코딩 결과를 골든 파일과 비교할 텍스트로 만듭니다. 에러는 메시지를 그대로 기록합니다.
+func render(resp any, err error) []byte {
+	if err != nil {
+		return []byte("error: " + err.Error() + "\n")
+	}
+	data, err := json.MarshalIndent(resp, "", "  ")
+	if err != nil {
+		return []byte("marshal error: " + err.Error() + "\n")
+	}
+	return append(data, '\n')
+}
+
+func TestDecoderGolden(t *testing.T) {
+	for endpoint, decode := range decoders {
+		fixtures, err := filepath.Glob(filepath.Join(goldenDir, endpoint, "*.json"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(fixtures) == 0 {
+			t.Errorf("%s 픽스처가 없습니다", endpoint)
+			continue
+		}
+
+		for _, fixture := range fixtures {
+			name := endpoint + "/" + strings.TrimSuffix(filepath.Base(fixture), ".json")
+			t.Run(name, func(t *testing.T) {
+				body, err := os.ReadFile(fixture)
+				if err != nil {
+					t.Fatal(err)
+				}
+				got := render(decode(fixtureClient(t, body)))
+
+				golden := strings.TrimSuffix(fixture, ".json") + ".golden"
+				if *update {
+					if err := os.WriteFile(golden, got, 0o644); err != nil {
+						t.Fatal(err)
+					}
+					return
+				}
+				want, err := os.ReadFile(golden)
+				if err != nil {
+					t.Fatalf("골든 파일을 읽을 수 없습니다 (-update로 생성): %v", err)
+				}
+				if !bytes.Equal(got, want) {
+					t.Errorf("디코딩 결과가 골든 파일과 다릅니다: %s\n기대값:\n%s\n결과값:\n%s", golden, want, got)
+				}
+			})
+		}
+	}
+}
+
+// TestDecoderAccessors는 모든 성공 픽스처에서 TotalCount가 head/row 분리 형식을 올바르게 읽는지 확인합니다.
+func TestDecoderAccessors(t *testing.T) {
+	type accessor interface{ TotalCount() int }
+	for endpoint, decode := range decoders {
+		t.Run(endpoint, func(t *testing.T) {
+			body, err := os.ReadFile(filepath.Join(goldenDir, endpoint, "success.json"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var envelope map[string][]struct {
+				Head []struct {
+					ListTotalCount int `json:"list_total_count"`
+				} `json:"head"`
+			}
+			if err := json.Unmarshal(body, &envelope); err != nil {
+				t.Fatal(err)
+			}
+			want := envelope[endpoint][0].Head[0].ListTotalCount
+
+			resp, err := decode(fixtureClient(t, body))
+			if err != nil {
+				t.Fatalf("에러 발생: %v", err)
+			}
+			if got := resp.(accessor).TotalCount(); got != want {
+				t.Errorf("기대값: %d, 결과값: %d", want, got)
+			}
+		})
+	}
+}
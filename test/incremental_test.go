@@ -0,0 +1,384 @@
+package assembly_go_test
+
+import (
+	"assembly_go"
+	"assembly_go/assemblytest"
+	"assembly_go/incremental"
+	"assembly_go/models"
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+// recordingSink는 받은 이벤트를 "<종류>:<Key>" 형태로 기록합니다.
+type recordingSink[T any] struct {
+	events []string
+	rows   map[string]T
+	fail   error
+}
+
+func (s *recordingSink[T]) Emit(_ context.Context, e incremental.Event[T]) error {
+	if s.fail != nil {
+		return s.fail
+	}
+	s.events = append(s.events, string(e.Kind)+":"+e.Key)
+	if s.rows == nil {
+		s.rows = make(map[string]T)
+	}
+	s.rows[e.Key] = e.Row
+	return nil
+}
+
+func (s *recordingSink[T]) take() []string {
+	events := s.events
+	s.events = nil
+	return events
+}
+
+func TestSyncBills(t *testing.T) {
+	srv := assemblytest.NewServer()
+	defer srv.Close()
+	client, err := srv.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 포털처럼 최신 제안순으로 넣습니다.
+	bills := []models.TVBPMBILL11Row{
+		{BillId: "B4", Age: "22", ProposeDate: "2024-06-04"},
+		{BillId: "B3", Age: "22", ProposeDate: "2024-06-03"},
+		{BillId: "B2", Age: "22", ProposeDate: "2024-06-02"},
+		{BillId: "B1", Age: "22", ProposeDate: "2024-06-01", ResolutionDate: "2024-06-05", PlenarySessionReviewResult: "원안가결"},
+		{BillId: "X1", Age: "21", ProposeDate: "2020-01-01"},
+	}
+	seed := func(rows ...models.TVBPMBILL11Row) {
+		srv.Reset()
+		srv.AddBills(rows...)
+	}
+	seed(bills...)
+
+	now := time.Date(2024, 6, 6, 9, 0, 0, 0, time.UTC)
+	store := incremental.NewMemoryStore()
+	sink := &recordingSink[models.TVBPMBILL11Row]{}
+	newSyncer := func(opts ...incremental.Option) *incremental.Syncer[models.TVBPMBILL11Row] {
+		opts = append([]incremental.Option{incremental.WithPageSize(2), incremental.WithClock(func() time.Time { return now })}, opts...)
+		s, err := incremental.New(client, incremental.Bills("22"), store, sink, opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+
+	t.Run("첫 동기화는 전체 조회", func(t *testing.T) {
+		stats, err := newSyncer().Sync(context.Background())
+		if err != nil {
+			t.Fatalf("에러 발생: %v", err)
+		}
+		want := []string{"added:B4", "added:B3", "added:B2", "added:B1"}
+		if got := sink.take(); !slices.Equal(got, want) {
+			t.Errorf("기대값: %v, 결과값: %v", want, got)
+		}
+		if !stats.Full || stats.Pages != 2 || stats.Added != 4 {
+			t.Errorf("통계가 올바르지 않습니다: %+v", stats)
+		}
+
+		cp, _ := store.Load(context.Background(), "TVBPMBILL11/AGE=22")
+		if cp.Cursors["PROPOSE_DT"] != "2024-06-04" || cp.Cursors["PROC_DT"] != "2024-06-05" {
+			t.Errorf("커서가 올바르지 않습니다: %v", cp.Cursors)
+		}
+		if cp.Page != 0 || len(cp.Rows) != 4 {
+			t.Errorf("체크포인트가 올바르지 않습니다: page=%d rows=%d", cp.Page, len(cp.Rows))
+		}
+	})
+
+	t.Run("바뀐 것이 없으면 첫 페이지와 날짜별 조회만", func(t *testing.T) {
+		seed(bills...)
+		stats, err := newSyncer().Sync(context.Background())
+		if err != nil {
+			t.Fatalf("에러 발생: %v", err)
+		}
+		if got := sink.take(); len(got) != 0 {
+			t.Errorf("이벤트가 없어야 합니다: %v", got)
+		}
+		// 목록 1페이지 + PROC_DT 2024-06-05, 2024-06-06
+		if stats.Full || stats.Pages != 3 {
+			t.Errorf("통계가 올바르지 않습니다: %+v", stats)
+		}
+		var procDates []string
+		for _, r := range srv.Requests() {
+			if d := r.Query.Get("PROC_DT"); d != "" {
+				procDates = append(procDates, d)
+			}
+			if r.Query.Get("AGE") != "22" {
+				t.Errorf("AGE 조건이 빠졌습니다: %v", r.Query)
+			}
+		}
+		if want := []string{"2024-06-05", "2024-06-06"}; !slices.Equal(procDates, want) {
+			t.Errorf("PROC_DT 조회 기대값: %v, 결과값: %v", want, procDates)
+		}
+	})
+
+	t.Run("새 법안과 뒤쪽 페이지의 의결을 찾음", func(t *testing.T) {
+		// B1은 마지막 페이지에 있어 목록 읽기가 멈춘 뒤 PROC_DT 조회로만 찾을 수 있습니다.
+		b1 := bills[3]
+		b1.ResolutionDate = "2024-06-06"
+		b1.PlenarySessionReviewResult = "수정가결"
+		bills = append([]models.TVBPMBILL11Row{{BillId: "B5", Age: "22", ProposeDate: "2024-06-06"}}, bills...)
+		bills[4] = b1
+		seed(bills...)
+
+		stats, err := newSyncer().Sync(context.Background())
+		if err != nil {
+			t.Fatalf("에러 발생: %v", err)
+		}
+		want := []string{"added:B5", "updated:B1"}
+		if got := sink.take(); !slices.Equal(got, want) {
+			t.Errorf("기대값: %v, 결과값: %v", want, got)
+		}
+		if sink.rows["B1"].PlenarySessionReviewResult != "수정가결" {
+			t.Errorf("바뀐 행이 전달되지 않았습니다: %+v", sink.rows["B1"])
+		}
+		if stats.Added != 1 || stats.Updated != 1 {
+			t.Errorf("통계가 올바르지 않습니다: %+v", stats)
+		}
+	})
+
+	t.Run("전체 조회는 삭제된 행을 찾음", func(t *testing.T) {
+		seed(bills[:4]...) // B1 삭제
+		stats, err := newSyncer(incremental.WithFullScan()).Sync(context.Background())
+		if err != nil {
+			t.Fatalf("에러 발생: %v", err)
+		}
+		want := []string{"removed:B1"}
+		if got := sink.take(); !slices.Equal(got, want) {
+			t.Errorf("기대값: %v, 결과값: %v", want, got)
+		}
+		if stats.Removed != 1 {
+			t.Errorf("통계가 올바르지 않습니다: %+v", stats)
+		}
+		cp, _ := store.Load(context.Background(), "TVBPMBILL11/AGE=22")
+		if _, ok := cp.Rows["B1"]; ok || len(cp.Rows) != 4 {
+			t.Errorf("삭제된 행의 해시는 체크포인트에서 지워야 합니다: %v", cp.Rows)
+		}
+	})
+
+	t.Run("전체 조회 중 RESULT 오류는 삭제로 보지 않음", func(t *testing.T) {
+		seed(bills[:4]...)
+		srv.InjectFault(assemblytest.Fault{Path: assemblytest.EndpointBills, ResultCode: assembly_go.ResultCodeServerError, Times: 1})
+		defer srv.ClearFaults()
+		stats, err := newSyncer(incremental.WithFullScan()).Sync(context.Background())
+		var apiErr *assembly_go.APIError
+		if !errors.As(err, &apiErr) || stats.Removed != 0 {
+			t.Errorf("ERROR-500 APIError와 삭제 없음을 기대했지만: %+v (%v)", stats, err)
+		}
+
+		// 첫 페이지는 정상으로 받고 두 번째 페이지부터 오류 응답
+		source := incremental.Bills("22")
+		fetch := source.Fetch
+		source.Fetch = func(c *assembly_go.Client, filter map[string]string, page, size int) ([]models.TVBPMBILL11Row, int, error) {
+			if page == 2 {
+				srv.InjectFault(assemblytest.Fault{Path: assemblytest.EndpointBills, ResultCode: assembly_go.ResultCodeServerError, Times: 1})
+			}
+			return fetch(c, filter, page, size)
+		}
+		s, err := incremental.New(client, source, store, sink, incremental.WithPageSize(2), incremental.WithFullScan(), incremental.WithClock(func() time.Time { return now }))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if stats, err := s.Sync(context.Background()); !errors.As(err, &apiErr) || stats.Removed != 0 {
+			t.Errorf("ERROR-500 APIError와 삭제 없음을 기대했지만: %+v (%v)", stats, err)
+		}
+		if got := sink.take(); len(got) != 0 {
+			t.Errorf("이벤트가 없어야 합니다: %v", got)
+		}
+		cp, _ := store.Load(context.Background(), "TVBPMBILL11/AGE=22")
+		if len(cp.Rows) != 4 {
+			t.Errorf("체크포인트의 행이 남아있어야 합니다: %v", cp.Rows)
+		}
+	})
+
+	t.Run("전체 행 수에 닿지 못한 전체 조회는 삭제로 보지 않음", func(t *testing.T) {
+		seed(bills[:4]...)
+		source := incremental.Bills("22")
+		fetch := source.Fetch
+		source.Fetch = func(c *assembly_go.Client, filter map[string]string, page, size int) ([]models.TVBPMBILL11Row, int, error) {
+			rows, total, err := fetch(c, filter, page, size)
+			if page == 2 {
+				rows = nil // 에러 없이 빈 페이지
+			}
+			return rows, total, err
+		}
+		s, err := incremental.New(client, source, store, sink, incremental.WithPageSize(2), incremental.WithFullScan(), incremental.WithClock(func() time.Time { return now }))
+		if err != nil {
+			t.Fatal(err)
+		}
+		stats, err := s.Sync(context.Background())
+		if err != nil {
+			t.Fatalf("에러 발생: %v", err)
+		}
+		if got := sink.take(); stats.Removed != 0 || len(got) != 0 {
+			t.Errorf("삭제 이벤트가 없어야 합니다: %+v %v", stats, got)
+		}
+	})
+
+	t.Run("오래된 체크포인트는 전체 조회", func(t *testing.T) {
+		now = now.AddDate(0, 2, 0)
+		defer func() { now = now.AddDate(0, -2, 0) }()
+		stats, err := newSyncer().Sync(context.Background())
+		if err != nil {
+			t.Fatalf("에러 발생: %v", err)
+		}
+		if !stats.Full {
+			t.Errorf("전체 조회여야 합니다: %+v", stats)
+		}
+	})
+
+	t.Run("WithFullScanEvery", func(t *testing.T) {
+		stats, err := newSyncer(incremental.WithFullScanEvery(1)).Sync(context.Background())
+		if err != nil {
+			t.Fatalf("에러 발생: %v", err)
+		}
+		if !stats.Full {
+			t.Errorf("전체 조회여야 합니다: %+v", stats)
+		}
+	})
+
+	t.Run("Sink가 실패하면 체크포인트를 반영하지 않음", func(t *testing.T) {
+		seed(append([]models.TVBPMBILL11Row{{BillId: "B6", Age: "22", ProposeDate: "2024-06-06"}}, bills[:4]...)...)
+		sink.fail = errors.New("db down")
+		if _, err := newSyncer().Sync(context.Background()); !errors.Is(err, sink.fail) {
+			t.Errorf("기대값: %v, 결과값: %v", sink.fail, err)
+		}
+		sink.fail = nil
+		if _, err := newSyncer().Sync(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		if got := sink.take(); len(got) != 1 || got[0] != "added:B6" {
+			t.Errorf("다시 실행하면 이벤트를 다시 전달해야 합니다: %v", got)
+		}
+	})
+}
+
+func TestSyncResume(t *testing.T) {
+	srv := assemblytest.NewServer()
+	defer srv.Close()
+	client, _ := srv.NewClient(assembly_go.WithRetries(1))
+	for i := range 5 {
+		srv.AddConferences(models.VCONFPHCONFLISTRow{CONF_ID: string(rune('A' + i)), ERACO: "제22대", CONF_DT: "2024-06-01"})
+	}
+
+	// 두 번째 페이지에서 한 번 실패하는 Source
+	source := incremental.Conferences("제22대")
+	fetch := source.Fetch
+	failed := false
+	source.Fetch = func(c *assembly_go.Client, filter map[string]string, page, size int) ([]models.VCONFPHCONFLISTRow, int, error) {
+		if page == 2 && !failed {
+			failed = true
+			return nil, 0, errors.New("connection reset")
+		}
+		return fetch(c, filter, page, size)
+	}
+
+	store := incremental.NewFileStore(t.TempDir())
+	sink := &recordingSink[models.VCONFPHCONFLISTRow]{}
+	s, err := incremental.New(client, source, store, sink, incremental.WithPageSize(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := s.Sync(context.Background()); err == nil {
+		t.Fatal("두 번째 페이지에서 실패해야 합니다")
+	}
+	cp, err := store.Load(context.Background(), source.ID())
+	if err != nil || cp == nil || cp.Page != 1 || !cp.Full {
+		t.Fatalf("첫 페이지까지 저장되어야 합니다: %+v (%v)", cp, err)
+	}
+
+	before := len(srv.Requests())
+	stats, err := s.Sync(context.Background())
+	if err != nil {
+		t.Fatalf("에러 발생: %v", err)
+	}
+	if got := srv.Requests()[before].Query.Get("pIndex"); got != "2" {
+		t.Errorf("이어받을 페이지 기대값: 2, 결과값: %s", got)
+	}
+	want := []string{"added:A", "added:B", "added:C", "added:D", "added:E"}
+	if got := sink.take(); !slices.Equal(got, want) {
+		t.Errorf("기대값: %v, 결과값: %v", want, got)
+	}
+	if stats.Removed != 0 {
+		t.Errorf("이어받은 전체 조회에서 앞 페이지 행을 삭제로 보면 안 됩니다: %+v", stats)
+	}
+	cp, _ = store.Load(context.Background(), source.ID())
+	if cp.Page != 0 || cp.Cursors["CONF_DT"] != "2024-06-01" || len(cp.Rows) != 5 {
+		t.Errorf("체크포인트가 올바르지 않습니다: %+v", cp)
+	}
+}
+
+func TestSyncConfig(t *testing.T) {
+	client, _ := assembly_go.NewClient("key")
+	sink := incremental.SinkFunc[models.TVBPMBILL11Row](func(context.Context, incremental.Event[models.TVBPMBILL11Row]) error { return nil })
+	if _, err := incremental.New(client, incremental.Bills("22"), nil, sink); !errors.Is(err, assembly_go.ErrInvalidConfig) {
+		t.Errorf("기대값: %v, 결과값: %v", assembly_go.ErrInvalidConfig, err)
+	}
+	source := incremental.Bills("22")
+	source.DateFilters = []string{"VOTE_DATE"}
+	if _, err := incremental.New(client, source, incremental.NewMemoryStore(), sink); !errors.Is(err, assembly_go.ErrInvalidConfig) {
+		t.Errorf("기대값: %v, 결과값: %v", assembly_go.ErrInvalidConfig, err)
+	}
+}
+
+func TestSyncDateFilters(t *testing.T) {
+	srv := assemblytest.NewServer()
+	defer srv.Close()
+	client, _ := srv.NewClient()
+	srv.AddVotes(
+		models.NojepdqqaweusdfbiRow{BillID: "B1", Age: "22", MemberCode: "M1", VoteDate: "2024-06-05", VoteResult: "찬성"},
+		models.NojepdqqaweusdfbiRow{BillID: "B1", Age: "22", MemberCode: "M2", VoteDate: "2024-06-05", VoteResult: "반대"},
+	)
+	srv.AddConferences(models.VCONFPHCONFLISTRow{CONF_ID: "C1", ERACO: "제22대", CONF_DT: "2024-06-05"})
+	srv.AddBillConferences(models.VCONFBILLCONFLISTRow{BillId: "B1", ConferenceId: "C1", ConferenceDate: "2024-06-05"})
+
+	now := time.Date(2024, 6, 6, 9, 0, 0, 0, time.UTC)
+	clock := incremental.WithClock(func() time.Time { return now })
+	// filtered는 동기화를 두 번(전체 조회, 증분) 실행하고 endpoint에 field 조건으로 보낸 날짜를 반환합니다.
+	filtered := func(t *testing.T, sync func() error, endpoint, field string) []string {
+		t.Helper()
+		for range 2 {
+			if err := sync(); err != nil {
+				t.Fatal(err)
+			}
+		}
+		var dates []string
+		for _, r := range srv.Requests() {
+			if d := r.Query.Get(field); d != "" && r.Path == "/"+endpoint {
+				dates = append(dates, d)
+			}
+		}
+		return dates
+	}
+
+	t.Run("표결은 VOTE_DATE", func(t *testing.T) {
+		s, _ := incremental.New(client, incremental.Votes("22", "B1"), incremental.NewMemoryStore(), &recordingSink[models.NojepdqqaweusdfbiRow]{}, clock)
+		got := filtered(t, func() error { _, err := s.Sync(context.Background()); return err }, assemblytest.EndpointVotes, "VOTE_DATE")
+		if want := []string{"2024-06-05", "2024-06-06"}; !slices.Equal(got, want) {
+			t.Errorf("기대값: %v, 결과값: %v", want, got)
+		}
+	})
+
+	t.Run("회의록은 CONF_DT", func(t *testing.T) {
+		s, _ := incremental.New(client, incremental.Conferences("제22대"), incremental.NewMemoryStore(), &recordingSink[models.VCONFPHCONFLISTRow]{}, clock)
+		got := filtered(t, func() error { _, err := s.Sync(context.Background()); return err }, assemblytest.EndpointConferences, "CONF_DT")
+		if want := []string{"2024-06-05", "2024-06-06"}; !slices.Equal(got, want) {
+			t.Errorf("기대값: %v, 결과값: %v", want, got)
+		}
+
+		b, _ := incremental.New(client, incremental.BillConferences("B1"), incremental.NewMemoryStore(), &recordingSink[models.VCONFBILLCONFLISTRow]{}, clock)
+		got = filtered(t, func() error { _, err := b.Sync(context.Background()); return err }, assemblytest.EndpointBillConferences, "CONF_DT")
+		if want := []string{"2024-06-05", "2024-06-06"}; !slices.Equal(got, want) {
+			t.Errorf("기대값: %v, 결과값: %v", want, got)
+		}
+	})
+}
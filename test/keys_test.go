@@ -0,0 +1,144 @@
+package assembly_go_test
+
+import (
+	"assembly_go"
+	"assembly_go/models"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// quotaHandler는 exhausted에 포함된 키에는 일별 트래픽 제한 코드를, 나머지 키에는 정상 응답을 반환합니다.
+func quotaHandler(exhausted map[string]string, seen *[]string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		key := r.URL.Query().Get("KEY")
+		*seen = append(*seen, key)
+		if code, ok := exhausted[key]; ok {
+			fmt.Fprintf(w, `{"RESULT":{"CODE":"%s","MESSAGE":"limit"}}`, code)
+			return
+		}
+		fmt.Fprint(w, `{"TVBPMBILL11":[{"head":[{"list_total_count":1,"RESULT":{"CODE":"INFO-000","MESSAGE":"정상 처리되었습니다."}}],"row":[{"BILL_ID":"B1"}]}]}`)
+	}
+}
+
+func TestAPIKeyRotation(t *testing.T) {
+	t.Run("한도 초과 시 다음 키로 전환", func(t *testing.T) {
+		var seen []string
+		server := httptest.NewServer(quotaHandler(map[string]string{"K1": assembly_go.ResultCodeDailyLimit}, &seen))
+		defer server.Close()
+
+		client, err := assembly_go.NewClient("", assembly_go.WithBaseURL(server.URL), assembly_go.WithAPIKeys("K1", "K2"))
+		if err != nil {
+			t.Fatalf("클라이언트 생성 시 에러 발생: %v", err)
+		}
+
+		for i := 0; i < 2; i++ {
+			resp, err := client.FetchBills(models.TVBPMBILL11RequestParams{})
+			if err != nil {
+				t.Fatalf("요청 중 에러 발생: %v", err)
+			}
+			if resp.TVBPMBILL11[0].Rows[0].BillId != "B1" {
+				t.Errorf("잘못된 데이터가 파싱되었습니다: %+v", resp)
+			}
+		}
+		if expected := []string{"K1", "K2", "K2"}; !reflect.DeepEqual(seen, expected) {
+			t.Errorf("키 사용 순서 기대값: %v, 결과값: %v", expected, seen)
+		}
+
+		usage := client.KeyProvider().(*assembly_go.RotatingKeyProvider).Usage()
+		if !usage[0].Exhausted || usage[0].Requests != 1 || usage[1].Requests != 2 {
+			t.Errorf("키별 사용량이 올바르지 않습니다: %+v", usage)
+		}
+	})
+
+	t.Run("모든 키가 사용 불가하면 ErrNoAPIKey", func(t *testing.T) {
+		var seen []string
+		server := httptest.NewServer(quotaHandler(map[string]string{
+			"K1": assembly_go.ResultCodeQuotaLimit,
+			"K2": assembly_go.ResultCodeInvalidKey,
+		}, &seen))
+		defer server.Close()
+
+		client, _ := assembly_go.NewClient("", assembly_go.WithBaseURL(server.URL), assembly_go.WithAPIKeys("K1", "K2"))
+		_, err := client.FetchApiData("TVBPMBILL11", http.MethodGet, nil)
+		if !errors.Is(err, assembly_go.ErrNoAPIKey) {
+			t.Fatalf("예상 에러: %v, 실제 에러: %v", assembly_go.ErrNoAPIKey, err)
+		}
+		var apiErr *assembly_go.APIError
+		if !errors.As(err, &apiErr) || apiErr.Code != assembly_go.ResultCodeInvalidKey {
+			t.Errorf("마지막 결과 코드가 에러에 포함되어야 합니다: %v", err)
+		}
+
+		// 이후 요청은 서버에 보내지 않고 바로 실패해야 합니다.
+		before := len(seen)
+		if _, err := client.FetchApiData("TVBPMBILL11", http.MethodGet, nil); !errors.Is(err, assembly_go.ErrNoAPIKey) {
+			t.Errorf("예상 에러: %v, 실제 에러: %v", assembly_go.ErrNoAPIKey, err)
+		}
+		if len(seen) != before {
+			t.Errorf("사용 불가한 키로 요청을 보냈습니다: %v", seen[before:])
+		}
+	})
+
+	t.Run("키와 무관한 오류 코드는 본문을 그대로 반환", func(t *testing.T) {
+		body := `{"RESULT":{"CODE":"ERROR-300","MESSAGE":"필수 값이 누락되어 있습니다."}}`
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, body)
+		}
+		server, client := mockServerAndClient(handler)
+		defer server.Close()
+
+		got, err := client.FetchApiData("TVBPMBILL11", http.MethodGet, nil)
+		if err != nil {
+			t.Fatalf("에러가 없어야 하지만 실제 에러: %v", err)
+		}
+		if string(got) != body {
+			t.Errorf("기대값: %s, 결과값: %s", body, got)
+		}
+	})
+
+	t.Run("API 키 없이 생성하면 ErrNoAPIKey", func(t *testing.T) {
+		if _, err := assembly_go.NewClient("", assembly_go.WithAPIKeys("", " ")); !errors.Is(err, assembly_go.ErrNoAPIKey) {
+			t.Errorf("예상 에러: %v, 실제 에러: %v", assembly_go.ErrNoAPIKey, err)
+		}
+	})
+}
+
+func TestLoadAPIKeysFile(t *testing.T) {
+	dir := t.TempDir()
+	testCases := []struct {
+		name     string
+		content  string
+		expected []string
+	}{
+		{"줄 단위 텍스트", "# 운영 키\nK1\n\n  K2  \n", []string{"K1", "K2"}},
+		{"JSON 배열", `["K1", "K2", "K3"]`, []string{"K1", "K2", "K3"}},
+	}
+
+	for i, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(dir, fmt.Sprintf("keys-%d", i))
+			os.WriteFile(path, []byte(tc.content), 0o600)
+
+			keys, err := assembly_go.LoadAPIKeysFile(path)
+			if err != nil {
+				t.Fatalf("키 파일 읽기 중 에러 발생: %v", err)
+			}
+			if !reflect.DeepEqual(keys, tc.expected) {
+				t.Errorf("기대값: %v, 결과값: %v", tc.expected, keys)
+			}
+		})
+	}
+
+	t.Run("키가 없는 파일", func(t *testing.T) {
+		path := filepath.Join(dir, "empty")
+		os.WriteFile(path, []byte("# nothing\n"), 0o600)
+		if _, err := assembly_go.LoadAPIKeysFile(path); !errors.Is(err, assembly_go.ErrNoAPIKey) {
+			t.Errorf("예상 에러: %v, 실제 에러: %v", assembly_go.ErrNoAPIKey, err)
+		}
+	})
+}
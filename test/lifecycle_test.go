@@ -0,0 +1,115 @@
+package assembly_go_test
+
+import (
+	"assembly_go/lifecycle"
+	"assembly_go/models"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestLifecycle(t *testing.T) {
+	now := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("본회의 의결까지", func(t *testing.T) {
+		lc := lifecycle.Derive(models.TVBPMBILL11Row{
+			BillId:                             "PRC_A",
+			ProposeDate:                        "2024-06-01",
+			SubmitDate:                         "2024-06-03",
+			CommitteePresentDate:               strPtr("2024-06-10"),
+			CommitteeProcessDate:               strPtr("2024-06-20"),
+			LegislationAndJudiciarySubmitDate:  strPtr("2024-06-21"),
+			LegislationAndJudiciaryPresentDate: strPtr("2024-06-25"),
+			LegislationAndJudiciaryProcessDate: strPtr("2024-06-26"),
+			ResolutionDate:                     "2024-06-28",
+			PlenarySessionReviewResult:         "원안가결",
+		}, now)
+
+		var stages []lifecycle.Stage
+		for _, tr := range lc.Timeline {
+			stages = append(stages, tr.Stage)
+		}
+		want := []lifecycle.Stage{
+			lifecycle.StageProposed, lifecycle.StageCommittee, lifecycle.StageCommitteeReview, lifecycle.StageCommitteeDone,
+			lifecycle.StageJudiciary, lifecycle.StageJudiciaryReview, lifecycle.StageJudiciaryDone, lifecycle.StageDecided,
+		}
+		if !slices.Equal(stages, want) {
+			t.Errorf("기대값: %v, 결과값: %v", want, stages)
+		}
+		if lc.Stage != lifecycle.StageDecided || lc.Result != "원안가결" || !lc.Valid() || lc.CurrentDays(now) != 0 {
+			t.Errorf("결과값: %+v", lc)
+		}
+		if d := lc.DaysIn(lifecycle.StageCommitteeReview); d != 10 {
+			t.Errorf("소관위 심사 기간 기대값: 10, 결과값: %d", d)
+		}
+	})
+
+	t.Run("소관위 계류", func(t *testing.T) {
+		lc := lifecycle.Derive(models.TVBPMBILL11Row{BillId: "PRC_B", ProposeDate: "20240601", SubmitDate: "2024.06.03"}, now)
+		if lc.Stage != lifecycle.StageCommittee || lc.CurrentDays(now) != 90 || !lc.Valid() {
+			t.Errorf("결과값: %+v (%d일)", lc, lc.CurrentDays(now))
+		}
+		last := lc.Spans[len(lc.Spans)-1]
+		if !last.End.IsZero() || last.Days != 90 {
+			t.Errorf("현재 단계 기간 결과값: %+v", last)
+		}
+	})
+
+	t.Run("위원회에서 폐기", func(t *testing.T) {
+		lc := lifecycle.Derive(models.TVBPMBILL11Row{
+			BillId:                 "PRC_C",
+			ProposeDate:            "2024-06-01",
+			SubmitDate:             "2024-06-03",
+			CommitteeProcessDate:   strPtr("2024-07-01"),
+			CommitteeProcessResult: strPtr("대안반영폐기"),
+		}, now)
+		if lc.Stage != lifecycle.StageClosed || lc.Result != "대안반영폐기" || !lc.Stage.Terminal() {
+			t.Errorf("결과값: %+v", lc)
+		}
+	})
+
+	t.Run("날짜 오류", func(t *testing.T) {
+		lc := lifecycle.Derive(models.TVBPMBILL11Row{
+			BillId:               "PRC_D",
+			ProposeDate:          "2024-06-10",
+			SubmitDate:           "2024-06-01", // 접수보다 이름
+			CommitteePresentDate: strPtr("someday"),
+			ResolutionDate:       "2025-01-01", // 미래
+		}, now)
+		var fields []string
+		for _, issue := range lc.Issues {
+			fields = append(fields, issue.Field)
+		}
+		if want := []string{"COMMITTEE_DT", "CMT_PRESENT_DT", "PROC_DT"}; !slices.Equal(fields, want) {
+			t.Errorf("기대값: %v, 결과값: %v", want, lc.Issues)
+		}
+		for _, s := range lc.Spans {
+			if s.Days < 0 {
+				t.Errorf("기간은 음수가 될 수 없습니다: %+v", s)
+			}
+		}
+	})
+
+	t.Run("날짜 없음", func(t *testing.T) {
+		lc := lifecycle.Derive(models.TVBPMBILL11Row{BillId: "PRC_E"}, now)
+		if lc.Stage != lifecycle.StageUnknown || len(lc.Timeline) != 0 || lc.CurrentDays(now) != 0 {
+			t.Errorf("결과값: %+v", lc)
+		}
+	})
+
+	t.Run("소관위 장기 계류 법안", func(t *testing.T) {
+		rows := []models.TVBPMBILL11Row{
+			{BillId: "NEW", SubmitDate: "2024-08-20"},
+			{BillId: "OLD", SubmitDate: "2024-03-01"},
+			{BillId: "REVIEW", SubmitDate: "2024-04-01", CommitteePresentDate: strPtr("2024-05-01")},
+			{BillId: "DONE", SubmitDate: "2024-01-01", ResolutionDate: "2024-02-01"},
+		}
+		var ids []string
+		for _, lc := range lifecycle.Stuck(rows, now, 30, lifecycle.CommitteeStages...) {
+			ids = append(ids, lc.BillID)
+		}
+		if want := []string{"OLD", "REVIEW"}; !slices.Equal(ids, want) {
+			t.Errorf("기대값: %v, 결과값: %v", want, ids)
+		}
+	})
+}
@@ -0,0 +1,274 @@
+package assembly_go_test
+
+import (
+	"assembly_go"
+	"assembly_go/assemblytest"
+	"assembly_go/localstore"
+	"assembly_go/models"
+	"assembly_go/sink"
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func openStore(t *testing.T, dir string) *localstore.Store {
+	t.Helper()
+	store, err := localstore.Open(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { store.Close() })
+	return store
+}
+
+func billIDs(rows []models.TVBPMBILL11Row) []string {
+	ids := make([]string, len(rows))
+	for i, r := range rows {
+		ids[i] = r.BillId
+	}
+	return ids
+}
+
+func TestLocalStore(t *testing.T) {
+	dir := t.TempDir()
+	store := openStore(t, dir)
+	bills := []models.TVBPMBILL11Row{
+		{BillId: "PRC_A", Age: "22", ProposeDate: "2024-06-01", BillName: "가법"},
+		{BillId: "PRC_B", Age: "22", ProposeDate: "2024-07-15", BillName: "나법"},
+		{BillId: "PRC_C", Age: "22", ProposeDate: "2024-06-30", BillName: "다법"},
+		{BillId: "PRC_D", Age: "21", ProposeDate: "2020-01-02", BillName: "라법"},
+	}
+	if err := store.Bills().Put(bills...); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("기본 정렬은 최근 제안일 순", func(t *testing.T) {
+		res, err := store.Bills().Find(localstore.Query{Where: map[string]string{"AGE": "22"}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := []string{"PRC_B", "PRC_C", "PRC_A"}; !slices.Equal(billIDs(res.Rows), want) || res.Total != 3 {
+			t.Errorf("기대값: %v (3건), 결과값: %v (%d건)", want, billIDs(res.Rows), res.Total)
+		}
+	})
+
+	t.Run("범위, 정렬, 페이지", func(t *testing.T) {
+		res, err := store.Bills().Find(localstore.Query{
+			Ranges:  []localstore.Range{{Field: "PROPOSE_DT", From: "2024-06-01", To: "2024-06-30"}},
+			OrderBy: []localstore.Order{{Field: "BILL_NAME"}},
+			Page:    2,
+			Size:    1,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := []string{"PRC_C"}; !slices.Equal(billIDs(res.Rows), want) || res.Total != 2 {
+			t.Errorf("기대값: %v (2건), 결과값: %v (%d건)", want, billIDs(res.Rows), res.Total)
+		}
+	})
+
+	t.Run("숫자 필드는 숫자로 정렬", func(t *testing.T) {
+		store.Bills().Put(models.TVBPMBILL11Row{BillId: "PRC_OLD", Age: "9"})
+		defer store.Bills().Delete("PRC_OLD")
+		res, _ := store.Bills().Find(localstore.Query{OrderBy: []localstore.Order{{Field: "AGE"}}, Size: 1})
+		if want := []string{"PRC_OLD"}; !slices.Equal(billIDs(res.Rows), want) {
+			t.Errorf("기대값: %v, 결과값: %v", want, billIDs(res.Rows))
+		}
+	})
+
+	t.Run("없는 필드", func(t *testing.T) {
+		_, err := store.Bills().Find(localstore.Query{Where: map[string]string{"NOPE": "1"}})
+		if !errors.Is(err, assembly_go.ErrInvalidConfig) {
+			t.Errorf("기대값: %v, 결과값: %v", assembly_go.ErrInvalidConfig, err)
+		}
+	})
+
+	t.Run("빈 Key", func(t *testing.T) {
+		err := store.Votes().Put(models.NojepdqqaweusdfbiRow{BillID: "PRC_A"})
+		if !errors.Is(err, assembly_go.ErrInvalidID) {
+			t.Errorf("기대값: %v, 결과값: %v", assembly_go.ErrInvalidID, err)
+		}
+	})
+
+	t.Run("다시 열어도 유지", func(t *testing.T) {
+		store.Bills().Put(models.TVBPMBILL11Row{BillId: "PRC_A", Age: "22", ProposeDate: "2024-06-01", BillName: "가법 (수정)"})
+		store.Bills().Delete("PRC_D")
+		if err := store.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		reopened := openStore(t, dir)
+		if n := reopened.Bills().Len(); n != 3 {
+			t.Errorf("행 수 기대값: 3, 결과값: %d", n)
+		}
+		if row, ok := reopened.Bills().Get("PRC_A"); !ok || row.BillName != "가법 (수정)" {
+			t.Errorf("기대값: 가법 (수정), 결과값: %+v", row)
+		}
+		if _, ok := reopened.Bills().Get("PRC_D"); ok {
+			t.Error("지운 행이 남아 있습니다")
+		}
+		store = reopened
+	})
+
+	t.Run("같은 내용은 다시 기록하지 않음", func(t *testing.T) {
+		path := filepath.Join(dir, "bills.jsonl")
+		before, _ := os.ReadFile(path)
+		row, _ := store.Bills().Get("PRC_B")
+		store.Bills().Put(row)
+		after, _ := os.ReadFile(path)
+		if !bytes.Equal(before, after) {
+			t.Error("변경이 없는데 로그가 늘었습니다")
+		}
+	})
+
+	t.Run("Compact", func(t *testing.T) {
+		path := filepath.Join(dir, "bills.jsonl")
+		if err := store.Compact(); err != nil {
+			t.Fatal(err)
+		}
+		data, _ := os.ReadFile(path)
+		if lines := bytes.Count(data, []byte("\n")); lines != 3 {
+			t.Errorf("줄 수 기대값: 3, 결과값: %d", lines)
+		}
+		store.Bills().Put(models.TVBPMBILL11Row{BillId: "PRC_E", Age: "22"})
+		if n := store.Bills().Len(); n != 4 {
+			t.Errorf("Compact 후 쓰기 실패: %d건", n)
+		}
+	})
+}
+
+func TestLocalStoreRecovery(t *testing.T) {
+	dir := t.TempDir()
+	store := openStore(t, dir)
+	store.Conferences().Put(models.VCONFPHCONFLISTRow{CONF_ID: "1", ERACO: "제22대"})
+	store.Close()
+
+	path := filepath.Join(dir, "conferences.jsonl")
+	f, _ := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o644)
+	f.WriteString(`{"op":"put","row":{"CONF_ID":"2"`) // 쓰는 도중 중단
+	f.Close()
+
+	store = openStore(t, dir)
+	if n := store.Conferences().Len(); n != 1 {
+		t.Errorf("행 수 기대값: 1, 결과값: %d", n)
+	}
+	store.Conferences().Put(models.VCONFPHCONFLISTRow{CONF_ID: "3", ERACO: "제22대"})
+	store.Close()
+	if n := openStore(t, dir).Conferences().Len(); n != 2 {
+		t.Errorf("잘린 줄을 정리한 뒤 이어 써야 합니다. 행 수 기대값: 2, 결과값: %d", n)
+	}
+
+	t.Run("줄바꿈만 빠진 마지막 줄", func(t *testing.T) {
+		dir := t.TempDir()
+		path := filepath.Join(dir, "conferences.jsonl")
+		os.WriteFile(path, []byte(`{"op":"put","row":{"CONF_ID":"1","ERACO":"제22대"}}`), 0o644)
+
+		store := openStore(t, dir)
+		if n := store.Conferences().Len(); n != 1 {
+			t.Errorf("행 수 기대값: 1, 결과값: %d", n)
+		}
+		store.Conferences().Put(models.VCONFPHCONFLISTRow{CONF_ID: "2", ERACO: "제22대"})
+		store.Close()
+
+		store, err := localstore.Open(dir)
+		if err != nil {
+			t.Fatalf("다시 열 때 에러 발생: %v", err)
+		}
+		defer store.Close()
+		if n := store.Conferences().Len(); n != 2 {
+			t.Errorf("행 수 기대값: 2, 결과값: %d", n)
+		}
+	})
+
+	t.Run("중간 줄 손상", func(t *testing.T) {
+		bad := t.TempDir()
+		os.WriteFile(filepath.Join(bad, "bills.jsonl"), []byte("not json\n{\"op\":\"put\",\"row\":{\"BILL_ID\":\"A\"}}\n"), 0o644)
+		if _, err := localstore.Open(bad); err == nil {
+			t.Error("손상된 파일은 에러를 반환해야 합니다")
+		}
+	})
+}
+
+// TestLocalStoreFetcher는 같은 데이터를 담은 가짜 서버와 로컬 저장소가 Fetcher로서 같은 결과를 내는지 확인합니다.
+func TestLocalStoreFetcher(t *testing.T) {
+	srv := assemblytest.NewServer()
+	defer srv.Close()
+	client, _ := srv.NewClient()
+	store := openStore(t, t.TempDir())
+
+	bills := []models.TVBPMBILL11Row{
+		{BillId: "PRC_A", Age: "22", ProposeDate: "2024-06-01"},
+		{BillId: "PRC_B", Age: "22", ProposeDate: "2024-06-02"},
+		{BillId: "PRC_C", Age: "21", ProposeDate: "2020-06-02"},
+	}
+	votes := []models.NojepdqqaweusdfbiRow{
+		{BillID: "PRC_A", MemberCode: "M1", MemberName: "홍길동", Age: "22", VoteResult: "찬성"},
+		{BillID: "PRC_A", MemberCode: "M2", MemberName: "김철수", Age: "22", VoteResult: "반대"},
+		{BillID: "PRC_B", MemberCode: "M1", MemberName: "홍길동", Age: "22", VoteResult: "찬성"},
+	}
+	conferences := []models.VCONFPHCONFLISTRow{
+		{CONF_ID: "C1", ERACO: "제22대", CONF_DT: "2024-06-05"},
+		{CONF_ID: "C2", ERACO: "제21대", CONF_DT: "2020-06-05"},
+	}
+	srv.AddBills(bills...)
+	srv.AddVotes(votes...)
+	srv.AddConferences(conferences...)
+
+	// 온라인에서 받아 로컬에 채웁니다.
+	if _, err := sink.Copy(store.Bills(), assembly_go.Paginate(2, billPages(client, ""))); err != nil {
+		t.Fatal(err)
+	}
+	store.Votes().Put(votes...)
+	store.Conferences().Put(conferences...)
+
+	for _, tc := range []struct {
+		name    string
+		fetcher assembly_go.Fetcher
+	}{{"온라인", client}, {"오프라인", store}} {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := tc.fetcher.FetchBillsWithOptions(models.TVBPMBILL11RequestParams{Pindex: "1", Psize: "10"}, models.TVBPMBILL11OptionalParams{AGE: "22"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			ids := billIDs(resp.AllRows())
+			slices.Sort(ids)
+			if want := []string{"PRC_A", "PRC_B"}; !slices.Equal(ids, want) || resp.TotalCount() != 2 {
+				t.Errorf("기대값: %v (2건), 결과값: %v (%d건)", want, ids, resp.TotalCount())
+			}
+
+			result := "찬성"
+			vresp, err := tc.fetcher.FetchMemberVoteResultWithOptions(
+				models.NojepdqqaweusdfbiRequestParams{Pindex: "1", Psize: "10", AGE: "22", BILL_ID: "PRC_A"},
+				models.NojepdqqaweusdfbiOptionalParams{RESULT_VOTE_MOD: &result},
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if rows := vresp.AllRows(); len(rows) != 1 || rows[0].MemberCode != "M1" {
+				t.Errorf("기대값: M1, 결과값: %+v", rows)
+			}
+
+			// 대수는 "22", "제22대" 어느 형식으로 주어도 같은 결과여야 합니다.
+			for _, eraco := range []string{"22", "제22대"} {
+				cresp, err := tc.fetcher.FetchMeetingConferenceList(models.VCONFPHCONFLISTRequestParams{Pindex: "1", Psize: "10", ERACO: eraco})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if rows := cresp.AllRows(); len(rows) != 1 || rows[0].CONF_ID != "C1" {
+					t.Errorf("ERACO=%s 기대값: C1, 결과값: %+v", eraco, rows)
+				}
+			}
+			if resp, _ := tc.fetcher.FetchBillsWithOptions(models.TVBPMBILL11RequestParams{}, models.TVBPMBILL11OptionalParams{AGE: "제22대"}); resp.TotalCount() != 2 {
+				t.Errorf("AGE=제22대 기대값: 2건, 결과값: %d건", resp.TotalCount())
+			}
+
+			empty, err := tc.fetcher.FetchBillsWithOptions(models.TVBPMBILL11RequestParams{}, models.TVBPMBILL11OptionalParams{AGE: "99"})
+			if err != nil || len(empty.AllRows()) != 0 || empty.TotalCount() != 0 {
+				t.Errorf("데이터가 없으면 빈 응답이어야 합니다: %+v (%v)", empty, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package assembly_go_test
+
+import (
+	"assembly_go/assemblytest"
+	"assembly_go/member"
+	"assembly_go/models"
+	"slices"
+	"testing"
+)
+
+func TestMemberResolver(t *testing.T) {
+	details := []models.NwvrqwxyaytdsfvhuRow{
+		{MonaCd: "M1", HgNm: "홍길동", HjNm: strPtr("洪吉童"), BthDate: strPtr("1970-01-02"), PolyNm: strPtr("가당"),
+			TelNo: strPtr("02-784-0001"), Homepage: strPtr("https://hong.example/"), Cmits: strPtr("법제사법위원회, 정무위원회")},
+	}
+	all := []models.AllNameMemberRow{
+		{NaasCd: "M1", NaasNm: "홍길동", NaasChNm: "洪吉童", BirdyDt: "19700102", PlptNm: "나당",
+			NaasTelNo: "027840001", NaasHpUrl: "http://hong.example", BlngCmitNm: "정무위원회,법제사법위원회", NaasPic: "hong.jpg"},
+		{NaasCd: "M2", NaasNm: "김철수", NaasChNm: "金哲洙", BirdyDt: "19650505"},
+	}
+	historical := []models.NprlapfmaufmqytetRow{
+		{DaeSu: "21", Dae: "제21대 (가당)", Name: "홍길동", NameHan: "洪吉童", Birth: "1970.01.02"},
+		{DaeSu: "9", Dae: "제9대", Name: "홍길동", NameHan: "洪吉童", Birth: "1970.01.02"},
+		{DaeSu: "20", Dae: "제20대", Name: "김철수", Birth: "1965-05-05"}, // 한자 이름 없음
+		{DaeSu: "5", Dae: "제5대", Name: "이영희", NameHan: "李英姬", Birth: "1920-03-04"},
+		{DaeSu: "6", Dae: "제6대", Name: "이영희", NameHan: "李英姬", Birth: "19200304"},
+	}
+
+	r := member.NewResolver()
+	r.AddHistorical(historical...) // 추가 순서와 상관없이 합쳐야 함
+	r.AddAllNameMembers(all...)
+	r.AddMemberDetails(details...)
+	result := r.Resolve()
+
+	t.Run("코드로 합치기", func(t *testing.T) {
+		m, ok := result.Get("M1")
+		if !ok {
+			t.Fatal("M1을 찾을 수 없습니다")
+		}
+		if m.Party != "가당" || m.Photo != "hong.jpg" || m.BirthDate != "1970-01-02" {
+			t.Errorf("결과값: %+v", m)
+		}
+		want := []member.Source{member.SourceDetails, member.SourceAllName, member.SourceHistorical}
+		if !slices.Equal(m.Sources, want) {
+			t.Errorf("출처 기대값: %v, 결과값: %v", want, m.Sources)
+		}
+		wantTerms := []member.Term{{Age: "9", Affiliation: "제9대"}, {Age: "21", Affiliation: "제21대 (가당)"}}
+		if !slices.Equal(m.Terms, wantTerms) {
+			t.Errorf("대수 기대값: %v, 결과값: %v", wantTerms, m.Terms)
+		}
+	})
+
+	t.Run("한자 이름이 없으면 이름과 생년월일로 합치기", func(t *testing.T) {
+		m, _ := result.Get("M2")
+		if len(m.Terms) != 1 || m.Terms[0].Age != "20" {
+			t.Errorf("결과값: %+v", m)
+		}
+	})
+
+	t.Run("코드 없는 역대 의원", func(t *testing.T) {
+		if len(result.Members) != 3 {
+			t.Fatalf("의원 수 기대값: 3, 결과값: %d", len(result.Members))
+		}
+		m := result.Members[1] // 이름 순: 김철수, 이영희, 홍길동
+		if m.Code != "" || m.Name != "이영희" || len(m.Terms) != 2 || m.BirthDate != "1920-03-04" {
+			t.Errorf("결과값: %+v", m)
+		}
+	})
+
+	t.Run("출처 간 충돌", func(t *testing.T) {
+		var fields []string
+		for _, c := range result.Conflicts {
+			fields = append(fields, c.Field)
+		}
+		// 전화번호, 홈페이지, 위원회 목록은 표기만 다르므로 충돌이 아닙니다.
+		if want := []string{"party"}; !slices.Equal(fields, want) {
+			t.Fatalf("기대값: %v, 결과값: %v", want, fields)
+		}
+		c := result.Conflicts[0]
+		if c.Code != "M1" || c.Name != "홍길동" || len(c.Values) != 2 ||
+			c.Values[0] != (member.SourceValue{Source: member.SourceDetails, Value: "가당"}) {
+			t.Errorf("결과값: %+v", c)
+		}
+	})
+}
+
+func TestMemberFetch(t *testing.T) {
+	srv := assemblytest.NewServer()
+	defer srv.Close()
+	client, _ := srv.NewClient()
+	srv.AddMemberDetails(models.NwvrqwxyaytdsfvhuRow{MonaCd: "M1", HgNm: "홍길동", BthDate: strPtr("1970-01-02")})
+	srv.AddAllMembers(models.AllNameMemberRow{NaasCd: "M1", NaasNm: "홍길동", BirdyDt: "19700102", NaasPic: "hong.jpg"})
+	srv.AddHistoricalMembers(models.NprlapfmaufmqytetRow{DaeSu: "21", Name: "홍길동", Birth: "1970-01-02"})
+
+	r := member.NewResolver()
+	if err := r.Fetch(client, "21"); err != nil {
+		t.Fatal(err)
+	}
+	result := r.Resolve()
+	if len(result.Members) != 1 || result.Members[0].Photo != "hong.jpg" || len(result.Members[0].Terms) != 1 {
+		t.Errorf("결과값: %+v", result.Members)
+	}
+}
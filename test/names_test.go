@@ -0,0 +1,96 @@
+package assembly_go_test
+
+import (
+	"assembly_go/member"
+	"assembly_go/names"
+	"testing"
+)
+
+func TestNames(t *testing.T) {
+	t.Run("자모 분해와 초성", func(t *testing.T) {
+		if got := names.Decompose("한국 A"); got != "ㅎㅏㄴㄱㅜㄱ A" {
+			t.Errorf("결과값: %q", got)
+		}
+		if got := names.Initials("홍길동"); got != "ㅎㄱㄷ" {
+			t.Errorf("결과값: %q", got)
+		}
+		for _, q := range []string{"ㅇㅈㅁ", "이ㅈㅁ", "재ㅁ", "ㅈ명", "이 재 명"} {
+			if !names.MatchInitials("이재명", q) {
+				t.Errorf("%q는 이재명과 맞아야 합니다", q)
+			}
+		}
+		for _, q := range []string{"ㅇㅁ", "ㅈㅇ", "", "이재명님"} {
+			if names.MatchInitials("이재명", q) {
+				t.Errorf("%q는 이재명과 맞지 않아야 합니다", q)
+			}
+		}
+	})
+
+	t.Run("로마자 표기", func(t *testing.T) {
+		cases := map[string]string{
+			"홍길동": "Hong Gildong",
+			"한복남": "Han Boknam",
+			"김설리": "Gim Seolli",
+			"남궁민": "Namgung Min",
+			"최빛나": "Choe Bitna",
+		}
+		for name, want := range cases {
+			if got := names.RomanizeName(name); got != want {
+				t.Errorf("%s 기대값: %q, 결과값: %q", name, want, got)
+			}
+		}
+		if got := names.SurnameSpellings("이"); got[0] != "i" || len(got) < 2 || got[1] != "lee" {
+			t.Errorf("결과값: %v", got)
+		}
+	})
+
+	t.Run("유사도", func(t *testing.T) {
+		if s := names.Similarity("이재명", "이재멍"); s < 0.85 {
+			t.Errorf("모음 하나 차이는 가까워야 합니다: %.2f", s)
+		}
+		if s := names.Similarity("이재명", "박민수"); s > 0.5 {
+			t.Errorf("다른 이름은 멀어야 합니다: %.2f", s)
+		}
+		if d := names.Distance("kitten", "sitting"); d != 3 {
+			t.Errorf("기대값: 3, 결과값: %d", d)
+		}
+	})
+
+	t.Run("의원 찾기", func(t *testing.T) {
+		idx := names.NewIndex(
+			member.Member{Code: "M1", Name: "이재명", NameHanja: "李在明", NameEnglish: "LEE Jaemyung"},
+			member.Member{Code: "M2", Name: "이재정", NameHanja: "李載汀", NameEnglish: "LEE Jaejung"},
+			member.Member{Code: "M3", Name: "박민수", NameHanja: "朴敏洙"},
+			member.Member{Code: "M4", Name: "남궁민", NameEnglish: "NAMKOONG Min"},
+		)
+		cases := []struct {
+			query string
+			code  string
+			kind  names.MatchKind
+		}{
+			{"이재명", "M1", names.MatchExact},
+			{"ㅇㅈㅁ", "M1", names.MatchInitial},
+			{"재명", "M1", names.MatchPartial},
+			{"이재멍", "M1", names.MatchFuzzy},
+			{"李在明", "M1", names.MatchHanja},
+			{"Jae-myung Lee", "M1", names.MatchRoman},
+			{"Park Minsu", "M3", names.MatchRoman}, // 영문 이름이 없어도 로마자 표기로
+			{"namgoong min", "M4", names.MatchRoman},
+		}
+		for _, c := range cases {
+			matches := idx.FindMember(c.query)
+			if len(matches) == 0 || matches[0].Member.Code != c.code || matches[0].Kind != c.kind {
+				t.Errorf("%q 기대값: %s (%s), 결과값: %+v", c.query, c.code, c.kind, matches)
+			}
+		}
+		if matches := idx.FindMember("ㅇㅈ"); len(matches) != 2 {
+			t.Errorf("초성 ㅇㅈ은 두 의원과 맞아야 합니다: %+v", matches)
+		}
+		if matches := idx.FindMember("최영희"); len(matches) != 0 {
+			t.Errorf("없는 의원은 결과가 없어야 합니다: %+v", matches)
+		}
+		if matches := idx.FindMember("  "); matches != nil {
+			t.Errorf("빈 검색어는 nil이어야 합니다: %+v", matches)
+		}
+	})
+}
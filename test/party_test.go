@@ -0,0 +1,114 @@
+package assembly_go_test
+
+import (
+	"assembly_go/analysis/votes"
+	"assembly_go/party"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestParty(t *testing.T) {
+	reg := party.NewRegistry()
+	kst := time.FixedZone("KST", 9*60*60)
+	on := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, kst) }
+
+	t.Run("날짜로 같은 이름 구분", func(t *testing.T) {
+		cases := []struct {
+			name string
+			at   time.Time
+			want string
+		}{
+			{"민주당", on(2006, 1, 1), "millennium-democratic"},
+			{"민주당", on(2010, 1, 1), "united-democratic"},
+			{"민주당", on(2013, 6, 1), "democratic-united"},
+			{"민주당", time.Time{}, "democratic-united"}, // 날짜가 없으면 가장 최근
+			{"국민의당", on(2017, 1, 1), "people-party-2016"},
+			{"국민의당", on(2021, 1, 1), "people-party-2020"},
+			{" 새누리 당", on(2014, 1, 1), "grand-national"},
+			{"국민의힘", on(2020, 6, 1), "people-power"}, // 당명 변경 전 날짜도 가장 가까운 정당으로
+		}
+		for _, c := range cases {
+			p, ok := reg.Resolve(c.name, c.at)
+			if !ok || p.ID != c.want {
+				t.Errorf("%q %v 기대값: %s, 결과값: %s (%v)", c.name, c.at.Format("2006-01-02"), c.want, p.ID, ok)
+			}
+		}
+		if _, ok := reg.Resolve("없는당", time.Time{}); ok {
+			t.Error("모르는 정당은 false여야 합니다")
+		}
+	})
+
+	t.Run("계보", func(t *testing.T) {
+		latest, ok := reg.Latest("uri")
+		if !ok || latest.Name != "더불어민주당" {
+			t.Errorf("결과값: %+v", latest)
+		}
+		lineage, err := reg.Lineage("people-power")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var ids []string
+		for _, p := range lineage {
+			ids = append(ids, p.ID)
+		}
+		if ids[0] != "grand-national" || ids[len(ids)-1] != "people-power" || !slices.Contains(ids, "future-korea") {
+			t.Errorf("결과값: %v", ids)
+		}
+		gnp, _ := reg.Get("grand-national")
+		if !slices.Equal(gnp.Successors, []string{"people-power"}) || gnp.NameAt(on(2015, 1, 1)) != "새누리당" || !gnp.Dissolved().Equal(on(2020, 2, 16)) {
+			t.Errorf("결과값: %+v", gnp)
+		}
+		if _, err := reg.Lineage("nope"); !errors.Is(err, party.ErrUnknownParty) {
+			t.Errorf("ErrUnknownParty를 기대했지만 결과값: %v", err)
+		}
+	})
+
+	t.Run("정당 등록", func(t *testing.T) {
+		reg := party.NewRegistry()
+		err := reg.Register(party.Party{ID: "new", Predecessors: []string{"missing"}, Aliases: []party.Alias{{Name: "새당"}}})
+		if !errors.Is(err, party.ErrUnknownParty) {
+			t.Errorf("앞선 정당이 없으면 ErrUnknownParty를 기대했지만 결과값: %v", err)
+		}
+		if err := reg.Register(party.Party{ID: "justice", Aliases: []party.Alias{{Name: "정의당"}}}); !errors.Is(err, party.ErrDuplicateParty) {
+			t.Errorf("ErrDuplicateParty를 기대했지만 결과값: %v", err)
+		}
+		err = reg.Register(party.Party{ID: "merged", Predecessors: []string{"justice", "progressive"}, Aliases: []party.Alias{{Name: "통합당", From: on(2030, 1, 1)}}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := reg.Normalize("정의당", "2025-01-01"); got != "통합당" {
+			t.Errorf("기대값: 통합당, 결과값: %q", got)
+		}
+	})
+
+	t.Run("DAE 해석", func(t *testing.T) {
+		got := party.ParseAffiliation("제19대(민주통합당) 제20대 (더불어민주당), 제21대 더불어민주당")
+		want := []party.Affiliation{{Term: 19, Party: "민주통합당"}, {Term: 20, Party: "더불어민주당"}, {Term: 21, Party: "더불어민주당"}}
+		if !slices.Equal(got, want) {
+			t.Errorf("기대값: %+v, 결과값: %+v", want, got)
+		}
+		if got := party.ParseAffiliation(" 무소속 "); !slices.Equal(got, []party.Affiliation{{Party: "무소속"}}) {
+			t.Errorf("결과값: %+v", got)
+		}
+		resolved := reg.ResolveAffiliation("제18대 민주당")
+		if len(resolved) != 1 || resolved[0].Resolved.ID != "united-democratic" {
+			t.Errorf("제18대 임기 시작일 기준 민주당이어야 합니다: %+v", resolved)
+		}
+	})
+
+	t.Run("표결 집계 정당 묶기", func(t *testing.T) {
+		a := votes.NewAnalyzer(votes.WithPartyNormalizer(reg.Normalize))
+		a.Add(
+			voteRow("PRC_A", "2019-06-01", "M1", "자유한국당", "찬성"),
+			voteRow("PRC_B", "2021-06-01", "M1", "국민의힘", "찬성"),
+			voteRow("PRC_B", "2021-06-01", "M2", "알수없는당", "반대"),
+		)
+		report := a.Report()
+		p, ok := report.Party("국민의힘")
+		if !ok || p.Bills != 2 || len(report.Parties) != 2 {
+			t.Errorf("결과값: %+v", report.Parties)
+		}
+	})
+}
@@ -0,0 +1,255 @@
+package assembly_go_test
+
+import (
+	"assembly_go"
+	"assembly_go/assemblytest"
+	"assembly_go/models"
+	"assembly_go/sink"
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func billPages(client *assembly_go.Client, age string) assembly_go.PageFetcher[models.TVBPMBILL11Row] {
+	return func(pIndex, pSize int) ([]models.TVBPMBILL11Row, int, error) {
+		resp, err := client.FetchBillsWithOptions(
+			models.TVBPMBILL11RequestParams{Pindex: strconv.Itoa(pIndex), Psize: strconv.Itoa(pSize)},
+			models.TVBPMBILL11OptionalParams{AGE: age},
+		)
+		if err == nil {
+			err = assembly_go.CheckResult(resp)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.AllRows(), resp.TotalCount(), nil
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	srv := assemblytest.NewServer()
+	defer srv.Close()
+	client, _ := srv.NewClient(assembly_go.WithRetries(1))
+	for i := range 5 {
+		srv.AddBills(models.TVBPMBILL11Row{BillId: "PRC_" + strconv.Itoa(i), Age: "22"})
+	}
+
+	t.Run("모든 페이지", func(t *testing.T) {
+		var ids []string
+		for row, err := range assembly_go.Paginate(2, billPages(client, "22")) {
+			if err != nil {
+				t.Fatal(err)
+			}
+			ids = append(ids, row.BillId)
+		}
+		if want := []string{"PRC_0", "PRC_1", "PRC_2", "PRC_3", "PRC_4"}; !slices.Equal(ids, want) {
+			t.Errorf("기대값: %v, 결과값: %v", want, ids)
+		}
+	})
+
+	t.Run("중간에 멈추면 더 요청하지 않음", func(t *testing.T) {
+		before := srv.RequestCount(assemblytest.EndpointBills)
+		for range assembly_go.Paginate(2, billPages(client, "22")) {
+			break
+		}
+		if got := srv.RequestCount(assemblytest.EndpointBills) - before; got != 1 {
+			t.Errorf("요청 수 기대값: 1, 결과값: %d", got)
+		}
+	})
+
+	t.Run("조회 실패", func(t *testing.T) {
+		srv.InjectFault(assemblytest.Fault{Path: assemblytest.EndpointBills, ResultCode: "ERROR-500", Times: 1})
+		var apiErr *assembly_go.APIError
+		for _, err := range assembly_go.Paginate(2, billPages(client, "22")) {
+			if !errors.As(err, &apiErr) {
+				t.Errorf("기대값: APIError, 결과값: %v", err)
+			}
+		}
+	})
+}
+
+func TestJSONLSink(t *testing.T) {
+	srv := assemblytest.NewServer()
+	defer srv.Close()
+	client, _ := srv.NewClient()
+	srv.AddBills(
+		models.TVBPMBILL11Row{BillId: "PRC_A", Age: "22", BillName: "가법 <일부개정>"},
+		models.TVBPMBILL11Row{BillId: "PRC_B", Age: "22"},
+		models.TVBPMBILL11Row{BillId: "PRC_C", Age: "22"},
+	)
+
+	var buf bytes.Buffer
+	s := sink.NewJSONL[models.TVBPMBILL11Row](&buf)
+	n, err := sink.Copy(s, assembly_go.Paginate(2, billPages(client, "22")))
+	if err != nil || n != 3 {
+		t.Fatalf("기대값: 3건, 결과값: %d건 (%v)", n, err)
+	}
+	if err := s.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("줄 수 기대값: 3, 결과값: %d\n%s", len(lines), buf.String())
+	}
+	if !strings.Contains(lines[0], `"BILL_NAME":"가법 <일부개정>"`) {
+		t.Errorf("포털 필드 이름과 원문 그대로 써야 합니다: %s", lines[0])
+	}
+	var row models.TVBPMBILL11Row
+	if err := json.Unmarshal([]byte(lines[2]), &row); err != nil || row.BillId != "PRC_C" {
+		t.Errorf("기대값: PRC_C, 결과값: %+v (%v)", row, err)
+	}
+}
+
+func TestCSVSink(t *testing.T) {
+	rows := []models.TVBPMBILL11Row{
+		{BillId: "PRC_A", BillNumber: "2200001", BillName: "가법, 일부개정", CommitteeProcessResult: strPtr("수정가결")},
+		{BillId: "PRC_B", BillNumber: "2200002", BillName: "나법"},
+	}
+
+	t.Run("BOM과 구조체 순서의 열", func(t *testing.T) {
+		var buf bytes.Buffer
+		s, err := sink.NewCSV[models.TVBPMBILL11Row](&buf, sink.WithBOM())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := sink.WriteAll(s, rows); err != nil {
+			t.Fatal(err)
+		}
+		if err := s.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		data := buf.Bytes()
+		if !bytes.HasPrefix(data, []byte("\xef\xbb\xbf")) {
+			t.Fatalf("UTF-8 BOM으로 시작해야 합니다: %q", data[:8])
+		}
+		records, err := csv.NewReader(bytes.NewReader(data[3:])).ReadAll()
+		if err != nil {
+			t.Fatal(err)
+		}
+		header := records[0]
+		if len(header) != 23 || header[0] != "BILL_ID" || header[1] != "BILL_NO" || header[22] != "PROC_DT" {
+			t.Errorf("머리글이 구조체 태그 순서가 아닙니다: %v", header)
+		}
+		cmt := slices.Index(header, "CMT_PROC_RESULT_CD")
+		if records[1][3] != "가법, 일부개정" || records[1][cmt] != "수정가결" || records[2][cmt] != "" {
+			t.Errorf("값이 올바르지 않습니다: %v / %v", records[1], records[2])
+		}
+	})
+
+	t.Run("열 선택", func(t *testing.T) {
+		var buf bytes.Buffer
+		s, err := sink.NewCSV[models.TVBPMBILL11Row](&buf, sink.WithColumns("bill_no", "BILL_NAME"), sink.WithComma('\t'))
+		if err != nil {
+			t.Fatal(err)
+		}
+		sink.WriteAll(s, rows[1:])
+		s.Close()
+		if got, want := buf.String(), "BILL_NO\tBILL_NAME\n2200002\t나법\n"; got != want {
+			t.Errorf("기대값: %q, 결과값: %q", want, got)
+		}
+	})
+
+	t.Run("행이 없어도 머리글", func(t *testing.T) {
+		var buf bytes.Buffer
+		s, _ := sink.NewCSV[models.VCONFPHCONFLISTRow](&buf)
+		s.Close()
+		if got, want := buf.String(), "CONF_ID,ERACO,SESS,DGR,CONF_DT,CONF_KND,CMIT_CD,CMIT_NM,DOWN_URL\n"; got != want {
+			t.Errorf("기대값: %q, 결과값: %q", want, got)
+		}
+	})
+
+	t.Run("json.Number 필드", func(t *testing.T) {
+		var buf bytes.Buffer
+		s, _ := sink.NewCSV[models.NojepdqqaweusdfbiRow](&buf, sink.WithColumns("HG_NM", "AGE", "SESSION_CD"), sink.WithoutHeader())
+		s.Write(models.NojepdqqaweusdfbiRow{MemberName: "홍길동", Age: "22"})
+		s.Close()
+		if got, want := buf.String(), "홍길동,22,\n"; got != want {
+			t.Errorf("기대값: %q, 결과값: %q", want, got)
+		}
+	})
+
+	t.Run("잘못된 열", func(t *testing.T) {
+		if _, err := sink.NewCSV[models.TVBPMBILL11Row](&bytes.Buffer{}, sink.WithColumns("NOPE")); !errors.Is(err, assembly_go.ErrInvalidConfig) {
+			t.Errorf("기대값: %v, 결과값: %v", assembly_go.ErrInvalidConfig, err)
+		}
+		if _, err := sink.NewCSV[string](&bytes.Buffer{}); err == nil {
+			t.Error("구조체가 아닌 행 타입은 에러를 반환해야 합니다")
+		}
+	})
+}
+
+func TestPartitionedSink(t *testing.T) {
+	dir := t.TempDir()
+	rows := []models.TVBPMBILL11Row{
+		{BillId: "PRC_A", Age: "22", ProposeDate: "2024-06-01"},
+		{BillId: "PRC_B", Age: "22", ProposeDate: "2024-07-15"},
+		{BillId: "PRC_C", Age: "22", ProposeDate: "2024-06-30"},
+		{BillId: "PRC_D", Age: "21", ProposeDate: "20200101"},
+		{BillId: "PRC_E", Age: "../21"}, // 디렉터리 밖으로 나가지 않아야 함
+	}
+
+	s := sink.NewPartitioned(dir, "bills.jsonl",
+		sink.Nested(sink.ByAge[models.TVBPMBILL11Row](), sink.ByMonth[models.TVBPMBILL11Row]("PROPOSE_DT")),
+		sink.JSONLFactory[models.TVBPMBILL11Row]())
+	if err := sink.WriteAll(s, rows); err != nil {
+		t.Fatal(err)
+	}
+	paths := s.Paths()
+	if err := s.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string][]string{
+		"22/2024-06":         {"PRC_A", "PRC_C"},
+		"22/2024-07":         {"PRC_B"},
+		"21/2020-01":         {"PRC_D"},
+		"unknown/21/unknown": {"PRC_E"},
+	}
+	if len(paths) != len(want) {
+		t.Errorf("파티션 수 기대값: %d, 결과값: %v", len(want), paths)
+	}
+	for part, ids := range want {
+		f, err := os.Open(filepath.Join(dir, filepath.FromSlash(part), "bills.jsonl"))
+		if err != nil {
+			t.Errorf("%s: %v", part, err)
+			continue
+		}
+		var got []string
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			var row models.TVBPMBILL11Row
+			json.Unmarshal(scanner.Bytes(), &row)
+			got = append(got, row.BillId)
+		}
+		f.Close()
+		if !slices.Equal(got, ids) {
+			t.Errorf("%s 기대값: %v, 결과값: %v", part, ids, got)
+		}
+	}
+
+	t.Run("CSV 파티션과 없는 필드", func(t *testing.T) {
+		votes := sink.NewPartitioned(t.TempDir(), "votes.csv", sink.ByAge[models.NojepdqqaweusdfbiRow](),
+			sink.CSVFactory[models.NojepdqqaweusdfbiRow](sink.WithBOM()))
+		if err := votes.Write(models.NojepdqqaweusdfbiRow{MemberName: "홍길동", Age: "22"}); err != nil {
+			t.Fatal(err)
+		}
+		if err := votes.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		bad := sink.NewPartitioned(t.TempDir(), "x.jsonl", sink.ByAge[models.VCONFPHCONFLISTRow](), sink.JSONLFactory[models.VCONFPHCONFLISTRow]())
+		if err := bad.Write(models.VCONFPHCONFLISTRow{}); err == nil {
+			t.Error("AGE 필드가 없는 모델은 에러를 반환해야 합니다")
+		}
+	})
+}
@@ -0,0 +1,99 @@
+package assembly_go_test
+
+import (
+	"assembly_go"
+	"assembly_go/assemblytest"
+	"assembly_go/models"
+	"assembly_go/terms"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTerms(t *testing.T) {
+	t.Run("대수 목록", func(t *testing.T) {
+		all := terms.All()
+		if len(all) != 22 || all[0].Number != 1 || terms.Latest().Number != 22 {
+			t.Fatalf("결과값: %d개, 마지막 %v", len(all), terms.Latest())
+		}
+		for i := 1; i < len(all); i++ {
+			if !all[i].Start.After(all[i-1].End) {
+				t.Errorf("임기가 겹칩니다: %v, %v", all[i-1], all[i])
+			}
+		}
+		if term, ok := terms.Get(21); !ok || term.Label() != "제21대" || term.Age() != "21" {
+			t.Errorf("결과값: %+v", term)
+		}
+		if _, ok := terms.Get(23); ok {
+			t.Error("등록되지 않은 대수는 false여야 합니다")
+		}
+	})
+
+	t.Run("표기 해석", func(t *testing.T) {
+		for _, s := range []string{"22", "제22대", "22대", " 제 22 대 "} {
+			term, err := terms.Parse(s)
+			if err != nil || term.Number != 22 {
+				t.Errorf("%q 결과값: %v (%v)", s, term, err)
+			}
+		}
+		for _, s := range []string{"", "제대", "0", "-1", "이십이", "23", "22.0"} {
+			if _, err := terms.Parse(s); !errors.Is(err, terms.ErrUnknownTerm) {
+				t.Errorf("%q: ErrUnknownTerm을 기대했지만 결과값: %v", s, err)
+			}
+		}
+	})
+
+	t.Run("날짜로 대수 찾기", func(t *testing.T) {
+		kst := time.FixedZone("KST", 9*60*60)
+		cases := []struct {
+			date time.Time
+			want int
+		}{
+			{time.Date(2024, 5, 29, 23, 59, 0, 0, kst), 21},
+			{time.Date(2024, 5, 30, 0, 0, 0, 0, kst), 22},
+			{time.Date(2024, 5, 29, 15, 0, 0, 0, time.UTC), 22}, // 한국 시간 5월 30일 0시
+			{time.Date(1948, 5, 31, 0, 0, 0, 0, kst), 1},
+			{time.Date(1962, 1, 1, 0, 0, 0, 0, kst), 0},  // 5대와 6대 사이
+			{time.Date(1972, 12, 1, 0, 0, 0, 0, kst), 0}, // 8대와 9대 사이
+			{time.Date(1940, 1, 1, 0, 0, 0, 0, kst), 0},
+		}
+		for _, c := range cases {
+			term, ok := terms.TermForDate(c.date)
+			if ok != (c.want != 0) || term.Number != c.want {
+				t.Errorf("%v 기대값: %d, 결과값: %v (%v)", c.date, c.want, term.Number, ok)
+			}
+		}
+	})
+
+	t.Run("요청 인자 정규화", func(t *testing.T) {
+		srv := assemblytest.NewServer()
+		defer srv.Close()
+		client, _ := srv.NewClient()
+
+		client.FetchMemberVoteResult(models.NojepdqqaweusdfbiRequestParams{AGE: "제22대"})
+		client.FetchApiData(assemblytest.EndpointConferences, "GET", map[string]string{"ERACO": "22", "DAESU": "22대"})
+		client.FetchApiData(assemblytest.EndpointConferences, "GET", map[string]string{"ERACO": "전체"})
+		requests := srv.Requests()
+		if len(requests) != 3 {
+			t.Fatalf("요청 수 기대값: 3, 결과값: %d", len(requests))
+		}
+		if got := requests[0].Query.Get("AGE"); got != "22" {
+			t.Errorf("AGE 기대값: 22, 결과값: %q", got)
+		}
+		if got := requests[1].Query.Get("ERACO"); got != "제22대" {
+			t.Errorf("ERACO 기대값: 제22대, 결과값: %q", got)
+		}
+		if got := requests[1].Query.Get("DAESU"); got != "22" {
+			t.Errorf("DAESU 기대값: 22, 결과값: %q", got)
+		}
+		if got := requests[2].Query.Get("ERACO"); got != "전체" {
+			t.Errorf("읽을 수 없는 값은 그대로 보내야 합니다. 결과값: %q", got)
+		}
+
+		raw, _ := srv.NewClient(assembly_go.WithoutTermNormalization())
+		raw.FetchMemberVoteResult(models.NojepdqqaweusdfbiRequestParams{AGE: "제22대"})
+		if got := srv.Requests()[3].Query.Get("AGE"); got != "제22대" {
+			t.Errorf("WithoutTermNormalization이면 그대로 보내야 합니다. 결과값: %q", got)
+		}
+	})
+}
@@ -0,0 +1,108 @@
+package assembly_go_test
+
+import (
+	"assembly_go"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// roundTripperFunc는 함수를 http.RoundTripper로 사용하기 위한 어댑터입니다.
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
+
+func TestHTTPClientOptions(t *testing.T) {
+	slowServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+		fmt.Fprint(w, `{}`)
+	}))
+	defer slowServer.Close()
+
+	t.Run("WithTimeout은 WithHTTPClient로 넘긴 클라이언트를 수정하지 않음", func(t *testing.T) {
+		shared := &http.Client{Timeout: time.Minute}
+		orders := map[string][]assembly_go.Option{
+			"WithHTTPClient 먼저": {assembly_go.WithHTTPClient(shared), assembly_go.WithTimeout(20 * time.Millisecond)},
+			"WithTimeout 먼저":    {assembly_go.WithTimeout(20 * time.Millisecond), assembly_go.WithHTTPClient(shared)},
+		}
+		for name, options := range orders {
+			t.Run(name, func(t *testing.T) {
+				client, err := assembly_go.NewClient("TEST_API_KEY", append(options, assembly_go.WithBaseURL(slowServer.URL))...)
+				if err != nil {
+					t.Fatalf("클라이언트 생성 시 에러 발생: %v", err)
+				}
+				_, err = client.FetchApiData("any-endpoint", http.MethodGet, nil)
+				var urlErr *url.Error
+				if !errors.As(err, &urlErr) || !urlErr.Timeout() {
+					t.Errorf("옵션 순서와 무관하게 타임아웃이 적용되어야 합니다. 실제 에러: %v", err)
+				}
+				if shared.Timeout != time.Minute {
+					t.Errorf("공유 http.Client의 타임아웃이 변경되었습니다: %v", shared.Timeout)
+				}
+			})
+		}
+	})
+
+	t.Run("WithDownloadTimeout은 다운로드에만 적용", func(t *testing.T) {
+		client, _ := assembly_go.NewClient("TEST_API_KEY",
+			assembly_go.WithBaseURL(slowServer.URL),
+			assembly_go.WithRetries(1),
+			assembly_go.WithTimeout(time.Second),
+			assembly_go.WithDownloadTimeout(20*time.Millisecond),
+		)
+		if _, err := client.FetchApiData("any-endpoint", http.MethodGet, nil); err != nil {
+			t.Errorf("OpenAPI 요청은 성공해야 합니다: %v", err)
+		}
+		if _, err := client.DownloadMeetingRecord(slowServer.URL + "/record.pdf"); !errors.Is(err, assembly_go.ErrDownloadFailed) {
+			t.Errorf("다운로드는 타임아웃으로 실패해야 합니다: %v", err)
+		}
+	})
+
+	t.Run("WithProxyURL로 프록시를 거쳐 요청", func(t *testing.T) {
+		var proxied string
+		proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			proxied = r.URL.String() // 프록시는 절대 URL 형태의 요청을 받습니다.
+			fmt.Fprint(w, `{}`)
+		}))
+		defer proxy.Close()
+
+		client, err := assembly_go.NewClient("TEST_API_KEY",
+			assembly_go.WithBaseURL("http://open.assembly.invalid/portal/openapi"),
+			assembly_go.WithProxyURL(proxy.URL),
+			assembly_go.WithMaxIdleConnsPerHost(8),
+			assembly_go.WithConnectTimeout(time.Second),
+			assembly_go.WithHTTP2(false),
+		)
+		if err != nil {
+			t.Fatalf("클라이언트 생성 시 에러 발생: %v", err)
+		}
+		if _, err := client.FetchApiData("TVBPMBILL11", http.MethodGet, nil); err != nil {
+			t.Fatalf("요청 중 에러 발생: %v", err)
+		}
+		if u, _ := url.Parse(proxied); u == nil || u.Host != "open.assembly.invalid" {
+			t.Errorf("프록시가 원래 대상 URL을 받지 못했습니다: %q", proxied)
+		}
+	})
+
+	t.Run("잘못된 Transport 설정은 생성 시 에러", func(t *testing.T) {
+		custom := &http.Client{Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			return nil, errors.New("unused")
+		})}
+		testCases := map[string][]assembly_go.Option{
+			"사용자 RoundTripper에 Transport 옵션": {assembly_go.WithHTTPClient(custom), assembly_go.WithMaxConnsPerHost(4)},
+			"잘못된 프록시 URL":                    {assembly_go.WithProxyURL("::not a url")},
+			"없는 CA 파일":                       {assembly_go.WithRootCAFile("/nonexistent/ca.pem")},
+		}
+		for name, options := range testCases {
+			t.Run(name, func(t *testing.T) {
+				if _, err := assembly_go.NewClient("TEST_API_KEY", options...); !errors.Is(err, assembly_go.ErrInvalidConfig) {
+					t.Errorf("예상 에러: %v, 실제 에러: %v", assembly_go.ErrInvalidConfig, err)
+				}
+			})
+		}
+	})
+}
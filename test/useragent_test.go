@@ -0,0 +1,98 @@
+package assembly_go_test
+
+import (
+	"assembly_go"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// headerRecorder는 경로별로 마지막 요청 헤더를 기록하는 모의 서버를 생성합니다.
+func headerRecorder(t *testing.T) (*httptest.Server, map[string]http.Header) {
+	t.Helper()
+	seen := make(map[string]http.Header)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen[r.URL.Path] = r.Header.Clone()
+		if r.URL.Path == "/TVBPMBILL11" {
+			fmt.Fprint(w, `{}`)
+			return
+		}
+		w.Write([]byte("%PDF-1.4"))
+	}))
+	t.Cleanup(server.Close)
+	return server, seen
+}
+
+// requestAllPaths는 OpenAPI, 법안 PDF, 회의록 PDF 경로로 한 번씩 요청합니다.
+func requestAllPaths(t *testing.T, client *assembly_go.Client, serverURL string) {
+	t.Helper()
+	if _, err := client.FetchApiData("TVBPMBILL11", http.MethodGet, nil); err != nil {
+		t.Fatalf("OpenAPI 요청 중 에러 발생: %v", err)
+	}
+	if _, err := client.DownloadBill("bill-1"); err != nil {
+		t.Fatalf("법안 다운로드 중 에러 발생: %v", err)
+	}
+	if _, err := client.DownloadMeetingRecord(serverURL + "/record.pdf"); err != nil {
+		t.Fatalf("회의록 다운로드 중 에러 발생: %v", err)
+	}
+}
+
+func TestUserAgentAndHeaders(t *testing.T) {
+	paths := []string{"/TVBPMBILL11", "/filegate/sender30", "/record.pdf"}
+
+	t.Run("기본 User-Agent는 SDK 식별자", func(t *testing.T) {
+		server, seen := headerRecorder(t)
+		client, _ := assembly_go.NewClient("TEST_API_KEY", assembly_go.WithBaseURL(server.URL))
+		requestAllPaths(t, client, server.URL)
+
+		for _, path := range paths {
+			if ua := seen[path].Get("User-Agent"); ua != assembly_go.DefaultUserAgent {
+				t.Errorf("%s User-Agent 기대값: %q, 결과값: %q", path, assembly_go.DefaultUserAgent, ua)
+			}
+			if ct := seen[path].Get("Content-Type"); ct != "" {
+				t.Errorf("%s GET 요청에 Content-Type이 있습니다: %q", path, ct)
+			}
+		}
+		if accept := seen["/TVBPMBILL11"].Get("Accept"); accept != "application/json" {
+			t.Errorf("OpenAPI 요청의 Accept 기대값: application/json, 결과값: %q", accept)
+		}
+	})
+
+	t.Run("WithUserAgent와 WithHeader는 모든 경로에 적용", func(t *testing.T) {
+		server, seen := headerRecorder(t)
+		client, _ := assembly_go.NewClient("TEST_API_KEY",
+			assembly_go.WithBaseURL(server.URL),
+			assembly_go.WithUserAgent("archiver/1.0"),
+			assembly_go.WithHeader("X-Request-Source", "nightly"),
+		)
+		requestAllPaths(t, client, server.URL)
+
+		for _, path := range paths {
+			if ua := seen[path].Get("User-Agent"); ua != "archiver/1.0" {
+				t.Errorf("%s User-Agent 기대값: archiver/1.0, 결과값: %q", path, ua)
+			}
+			if v := seen[path].Get("X-Request-Source"); v != "nightly" {
+				t.Errorf("%s 기본 헤더가 없습니다: %q", path, v)
+			}
+		}
+	})
+
+	t.Run("WithBrowserUserAgent는 파일 게이트웨이에만 적용", func(t *testing.T) {
+		server, seen := headerRecorder(t)
+		client, _ := assembly_go.NewClient("TEST_API_KEY",
+			assembly_go.WithBaseURL(server.URL),
+			assembly_go.WithBrowserUserAgent(),
+		)
+		requestAllPaths(t, client, server.URL)
+
+		if ua := seen["/TVBPMBILL11"].Get("User-Agent"); ua != assembly_go.DefaultUserAgent {
+			t.Errorf("OpenAPI 요청은 기본 User-Agent를 사용해야 합니다: %q", ua)
+		}
+		for _, path := range paths[1:] {
+			if ua := seen[path].Get("User-Agent"); ua != assembly_go.BrowserUserAgent {
+				t.Errorf("%s User-Agent 기대값: %q, 결과값: %q", path, assembly_go.BrowserUserAgent, ua)
+			}
+		}
+	})
+}
@@ -0,0 +1,243 @@
+package assembly_go_test
+
+import (
+	"assembly_go"
+	"assembly_go/analysis/votes"
+	"assembly_go/assemblytest"
+	"assembly_go/models"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func voteRow(bill, date, code, party, result string) models.NojepdqqaweusdfbiRow {
+	return models.NojepdqqaweusdfbiRow{BillID: bill, VoteDate: date, MemberCode: code, MemberName: "의원" + code, PartyName: party, VoteResult: result, Age: "22"}
+}
+
+func TestVoteAnalyzer(t *testing.T) {
+	a := votes.NewAnalyzer()
+	a.Add(
+		// PRC_A: 가당 3명 중 2명 찬성, 1명 반대 / 나당 2명 모두 반대
+		voteRow("PRC_A", "2024-06-10", "M1", "가당", "찬성"),
+		voteRow("PRC_A", "2024-06-10", "M2", "가당", "찬성"),
+		voteRow("PRC_A", "2024-06-10", "M3", "가당", "반대"),
+		voteRow("PRC_A", "2024-06-10", "M4", "나당", "반대"),
+		voteRow("PRC_A", "2024-06-10", "M5", "나당", "반대"),
+		// PRC_B: 가당 찬성 1, 불참 1, 기권 1 (동수라 당론 없음) / 나당 1명 불참, 1명 찬성
+		voteRow("PRC_B", "2024-06-01", "M1", "가당", "찬성"),
+		voteRow("PRC_B", "2024-06-01", "M2", "가당", "불참"),
+		voteRow("PRC_B", "2024-06-01", "M3", "가당", "기권"),
+		voteRow("PRC_B", "2024-06-01", "M4", "나당", "불참"),
+		voteRow("PRC_B", "2024-06-01", "M5", "다당", "찬성"), // 당적 변경
+		voteRow("PRC_B", "2024-06-01", "M5", "다당", "반대"), // 중복 행은 무시
+		models.NojepdqqaweusdfbiRow{VoteResult: "찬성"},    // BILL_ID 없음
+	)
+	if a.Len() != 11 {
+		t.Errorf("행 수 기대값: 11, 결과값: %d", a.Len())
+	}
+	report := a.Report()
+
+	t.Run("법안별 집계", func(t *testing.T) {
+		if len(report.Bills) != 2 || report.Bills[0].BillID != "PRC_B" {
+			t.Fatalf("표결일 순이어야 합니다: %+v", report.Bills)
+		}
+		bill, _ := report.Bill("PRC_A")
+		if want := (assembly_go.VoteTally{Yes: 2, No: 3, Total: 5}); bill.Tally != want {
+			t.Errorf("기대값: %+v, 결과값: %+v", want, bill.Tally)
+		}
+		if want := (assembly_go.VoteTally{Yes: 2, No: 1, Total: 3}); bill.Parties["가당"] != want {
+			t.Errorf("가당 기대값: %+v, 결과값: %+v", want, bill.Parties["가당"])
+		}
+		b, _ := report.Bill("PRC_B")
+		if want := (assembly_go.VoteTally{Yes: 2, Abstain: 1, Absent: 2, Total: 5}); b.Tally != want {
+			t.Errorf("중복 행 제외 기대값: %+v, 결과값: %+v", want, b.Tally)
+		}
+	})
+
+	t.Run("정당 응집도", func(t *testing.T) {
+		p, _ := report.Party("가당")
+		// PRC_A: |2-1|/3, PRC_B: |1-0|/1
+		if want := (1.0/3 + 1) / 2; math.Abs(p.Rice-want) > 1e-9 || p.RiceBills != 2 || p.Bills != 2 {
+			t.Errorf("기대값: %.4f, 결과값: %+v", want, p)
+		}
+		n, _ := report.Party("나당")
+		if n.Rice != 1 || n.RiceBills != 1 || n.Bills != 2 {
+			t.Errorf("불참만 있는 법안은 Rice에서 빠져야 합니다: %+v", n)
+		}
+	})
+
+	t.Run("의원 출석률과 당론 일치율", func(t *testing.T) {
+		m3, _ := report.Member("M3")
+		if m3.Attendance != 1 || m3.PartyLineVotes != 1 || m3.Agreed != 0 || m3.Agreement != 0 {
+			t.Errorf("M3 결과값: %+v", m3)
+		}
+		m2, _ := report.Member("M2")
+		if m2.Attendance != 0.5 || m2.PartyLineVotes != 1 || m2.Agreement != 1 {
+			t.Errorf("M2 결과값: %+v", m2)
+		}
+		m5, _ := report.Member("M5")
+		if m5.Party != "나당" || m5.Tally.Total != 2 || m5.Agreed != 2 {
+			t.Errorf("최근 표결의 정당을 써야 합니다. M5 결과값: %+v", m5)
+		}
+	})
+}
+
+func TestVoteAnalyzerSeq(t *testing.T) {
+	srv := assemblytest.NewServer()
+	defer srv.Close()
+	client, _ := srv.NewClient()
+	for i := range 25 {
+		srv.AddVotes(voteRow("PRC_A", "2024-06-10", "M"+strconv.Itoa(i), "가당", "찬성"))
+	}
+
+	a := votes.NewAnalyzer()
+	err := a.AddSeq(assembly_go.Paginate(10, func(pIndex, pSize int) ([]models.NojepdqqaweusdfbiRow, int, error) {
+		resp, err := client.FetchMemberVoteResult(models.NojepdqqaweusdfbiRequestParams{
+			Pindex: strconv.Itoa(pIndex), Psize: strconv.Itoa(pSize), AGE: "22", BILL_ID: "PRC_A",
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.AllRows(), resp.TotalCount(), nil
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bill, ok := a.Report().Bill("PRC_A"); !ok || bill.Tally.Yes != 25 {
+		t.Errorf("기대값: 찬성 25, 결과값: %+v", bill)
+	}
+}
+
+func TestVoteChoice(t *testing.T) {
+	rows := []models.NojepdqqaweusdfbiRow{
+		voteRow("PRC_A", "2024-06-10", "M1", "가당", " 찬성"),
+		voteRow("PRC_A", "2024-06-10", "M2", "가당", "반대 "),
+		voteRow("PRC_A", "2024-06-10", "M3", "가당", "기권"),
+		voteRow("PRC_A", "2024-06-10", "M4", "가당", "불참"),
+		voteRow("PRC_A", "2024-06-10", "M5", "가당", "무효"),
+	}
+	want := assembly_go.VoteTally{Yes: 1, No: 1, Abstain: 1, Absent: 1, Other: 1, Total: 5}
+	if got := assembly_go.TallyVotes(rows); got != want {
+		t.Errorf("기대값: %+v, 결과값: %+v", want, got)
+	}
+
+	a := votes.NewAnalyzer()
+	a.Add(rows...)
+	if bill, _ := a.Report().Bill("PRC_A"); bill.Tally != want {
+		t.Errorf("Analyzer와 TallyVotes의 집계가 달라서는 안 됩니다: %+v", bill.Tally)
+	}
+	if c := votes.ParseChoice(" 반대 "); c != votes.ChoiceNo || c.String() != "반대" {
+		t.Errorf("기대값: 반대, 결과값: %v", c)
+	}
+}
+
+func TestVoteSimilarity(t *testing.T) {
+	a := votes.NewAnalyzer()
+	// 가당(A1~A3)과 나당(B1~B3)이 PRC_1~4에서 반대로 표결하고, PRC_5에서는 모두 찬성
+	for i := range 5 {
+		bill := "PRC_" + strconv.Itoa(i+1)
+		for _, code := range []string{"A1", "A2", "A3"} {
+			a.Add(voteRow(bill, "2024-06-0"+strconv.Itoa(i+1), code, "가당", "찬성"))
+		}
+		result := "반대"
+		if i == 4 {
+			result = "찬성"
+		}
+		for _, code := range []string{"B1", "B2", "B3"} {
+			a.Add(voteRow(bill, "2024-06-0"+strconv.Itoa(i+1), code, "나당", result))
+		}
+	}
+	a.Add(voteRow("PRC_1", "2024-06-01", "C1", "다당", "불참")) // 참석 표결 없음
+
+	m := a.Agreement(1)
+	if len(m.Members) != 6 {
+		t.Fatalf("의원 수 기대값: 6, 결과값: %d", len(m.Members))
+	}
+
+	t.Run("일치율과 이웃", func(t *testing.T) {
+		neighbors := m.Neighbors("A1", 2, 1)
+		if len(neighbors) != 2 || neighbors[0].Agreement != 1 || neighbors[0].Party != "가당" || neighbors[1].Party != "가당" {
+			t.Errorf("결과값: %+v", neighbors)
+		}
+		all := m.Neighbors("A1", 0, 0)
+		last := all[len(all)-1]
+		if last.Party != "나당" || math.Abs(last.Agreement-0.2) > 1e-9 || last.Shared != 5 {
+			t.Errorf("다른 정당과의 일치율 기대값: 0.2, 결과값: %+v", last)
+		}
+		if m.Neighbors("NONE", 3, 0) != nil {
+			t.Error("없는 의원은 nil이어야 합니다")
+		}
+	})
+
+	t.Run("코드가 없는 의원", func(t *testing.T) {
+		b := votes.NewAnalyzer()
+		for i, result := range []string{"찬성", "반대", "찬성"} {
+			bill := "PRC_" + strconv.Itoa(i+1)
+			b.Add(voteRow(bill, "2024-06-01", "", "가당", "찬성"))
+			row := voteRow(bill, "2024-06-01", "", "나당", result)
+			row.MemberName = "의원B"
+			b.Add(row)
+		}
+		m := b.Agreement(1)
+		if len(m.Members) != 2 {
+			t.Fatalf("의원 수 기대값: 2, 결과값: %+v", m.Members)
+		}
+		neighbors := m.Neighbors("name:의원", 0, 0)
+		if len(neighbors) != 1 || neighbors[0].Name != "의원B" || neighbors[0].Shared != 3 {
+			t.Errorf("결과값: %+v", neighbors)
+		}
+	})
+
+	t.Run("이상점 추정", func(t *testing.T) {
+		points, err := a.IdealPoints(2, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if points.Bills != 5 || len(points.Explained) != 1 || points.Explained[0] < 0.99 {
+			t.Fatalf("두 진영만 있으면 1차원으로 설명되어야 합니다: %+v", points)
+		}
+		sign := map[string]float64{}
+		for _, p := range points.Points {
+			if s, ok := sign[p.Party]; ok && s*p.Coords[0] <= 0 {
+				t.Errorf("같은 정당은 같은 쪽이어야 합니다: %+v", p)
+			}
+			sign[p.Party] = p.Coords[0]
+		}
+		if sign["가당"]*sign["나당"] >= 0 {
+			t.Errorf("두 정당은 반대쪽이어야 합니다: %v", sign)
+		}
+		if _, err := a.IdealPoints(0, 1); err == nil {
+			t.Error("dims가 0이면 에러를 반환해야 합니다")
+		}
+	})
+
+	t.Run("CSV와 JSON", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := m.WriteCSV(&buf); err != nil {
+			t.Fatal(err)
+		}
+		records, err := csv.NewReader(&buf).ReadAll()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(records) != 7 || records[0][3] != "A1" || records[1][3] != "1.0000" {
+			t.Errorf("결과값: %v", records)
+		}
+
+		points, _ := a.IdealPoints(1, 1)
+		buf.Reset()
+		points.WriteCSV(&buf)
+		if !strings.HasPrefix(buf.String(), "code,name,party,dim1\n") {
+			t.Errorf("결과값: %q", buf.String())
+		}
+
+		data, err := json.Marshal(m)
+		if err != nil || !strings.Contains(string(data), `"agreement":[[1,`) {
+			t.Errorf("결과값: %s (%v)", data, err)
+		}
+	})
+}
@@ -0,0 +1,143 @@
+package assembly_go
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+)
+
+// defaultTimeout은 WithTimeout이나 WithHTTPClient가 없을 때 사용하는 요청 타임아웃입니다.
+const defaultTimeout = 30 * time.Second
+
+// httpSettings는 옵션으로 받은 HTTP 관련 설정입니다.
+// 옵션은 이 값만 기록하고, 실제 http.Client는 모든 옵션을 적용한 뒤 NewClient에서 한 번에 만듭니다.
+// 덕분에 옵션 순서와 무관하게 같은 결과를 얻고, 사용자가 넘긴 http.Client도 수정하지 않습니다.
+type httpSettings struct {
+	baseClient      *http.Client  // WithHTTPClient로 받은 클라이언트 (복사해서 사용)
+	timeout         time.Duration // OpenAPI 요청 전체 타임아웃
+	downloadTimeout time.Duration // PDF 다운로드 전체 타임아웃
+
+	// 아래 항목은 *http.Transport에 적용됩니다.
+	connectTimeout      time.Duration
+	maxIdleConns        int
+	maxIdleConnsPerHost int
+	maxConnsPerHost     int
+	idleConnTimeout     time.Duration
+	proxyURL            string
+	rootCAs             *x509.CertPool
+	rootCAFile          string
+	http2               *bool
+}
+
+// hasTransportSettings는 Transport를 조정해야 하는 옵션이 하나라도 있는지 확인합니다.
+func (s *httpSettings) hasTransportSettings() bool {
+	return s.connectTimeout > 0 || s.maxIdleConns > 0 || s.maxIdleConnsPerHost > 0 ||
+		s.maxConnsPerHost > 0 || s.idleConnTimeout > 0 || s.proxyURL != "" ||
+		s.rootCAs != nil || s.rootCAFile != "" || s.http2 != nil
+}
+
+// buildHTTPClients는 설정으로부터 OpenAPI 요청용 클라이언트와 다운로드용 클라이언트를 만듭니다.
+// 두 클라이언트는 같은 Transport(커넥션 풀)를 공유하고 전체 타임아웃만 다릅니다.
+func (s *httpSettings) buildHTTPClients() (api *http.Client, download *http.Client, err error) {
+	api = &http.Client{Timeout: defaultTimeout}
+	if s.baseClient != nil {
+		copied := *s.baseClient // 사용자의 http.Client를 수정하지 않도록 복사합니다.
+		api = &copied
+	}
+	if s.timeout > 0 {
+		api.Timeout = s.timeout
+	}
+
+	if s.hasTransportSettings() {
+		transport, err := s.buildTransport(api.Transport)
+		if err != nil {
+			return nil, nil, err
+		}
+		api.Transport = transport
+	}
+
+	copied := *api
+	download = &copied
+	if s.downloadTimeout > 0 {
+		download.Timeout = s.downloadTimeout
+	}
+	return api, download, nil
+}
+
+// buildTransport는 base를 복제한 뒤 Transport 관련 설정을 적용합니다.
+// base가 nil이면 http.DefaultTransport를 복제하며, *http.Transport가 아닌 RoundTripper에는 설정을 적용할 수 없습니다.
+func (s *httpSettings) buildTransport(base http.RoundTripper) (*http.Transport, error) {
+	var transport *http.Transport
+	switch rt := base.(type) {
+	case nil:
+		transport = http.DefaultTransport.(*http.Transport).Clone()
+	case *http.Transport:
+		transport = rt.Clone()
+	default:
+		return nil, fmt.Errorf("%w: transport options require *http.Transport, got %T", ErrInvalidConfig, base)
+	}
+
+	if s.connectTimeout > 0 {
+		dialer := &net.Dialer{Timeout: s.connectTimeout, KeepAlive: 30 * time.Second}
+		transport.DialContext = dialer.DialContext
+		transport.TLSHandshakeTimeout = s.connectTimeout
+	}
+	if s.maxIdleConns > 0 {
+		transport.MaxIdleConns = s.maxIdleConns
+	}
+	if s.maxIdleConnsPerHost > 0 {
+		transport.MaxIdleConnsPerHost = s.maxIdleConnsPerHost
+	}
+	if s.maxConnsPerHost > 0 {
+		transport.MaxConnsPerHost = s.maxConnsPerHost
+	}
+	if s.idleConnTimeout > 0 {
+		transport.IdleConnTimeout = s.idleConnTimeout
+	}
+
+	if s.proxyURL != "" {
+		proxy, err := url.Parse(s.proxyURL)
+		if err != nil || proxy.Scheme == "" || proxy.Host == "" {
+			return nil, fmt.Errorf("%w: invalid proxy URL %q", ErrInvalidConfig, s.proxyURL)
+		}
+		transport.Proxy = http.ProxyURL(proxy)
+	}
+
+	rootCAs := s.rootCAs
+	if s.rootCAFile != "" {
+		pem, err := os.ReadFile(s.rootCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidConfig, err)
+		}
+		if rootCAs == nil {
+			rootCAs = x509.NewCertPool()
+		} else {
+			rootCAs = rootCAs.Clone()
+		}
+		if !rootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("%w: no PEM certificates in %s", ErrInvalidConfig, s.rootCAFile)
+		}
+	}
+	if rootCAs != nil {
+		tlsConfig := &tls.Config{}
+		if transport.TLSClientConfig != nil {
+			tlsConfig = transport.TLSClientConfig.Clone()
+		}
+		tlsConfig.RootCAs = rootCAs
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	if s.http2 != nil {
+		transport.ForceAttemptHTTP2 = *s.http2
+		if !*s.http2 {
+			// 비어있는(nil이 아닌) TLSNextProto는 HTTP/2 업그레이드를 끕니다.
+			transport.TLSNextProto = make(map[string]func(string, *tls.Conn) http.RoundTripper)
+		}
+	}
+	return transport, nil
+}
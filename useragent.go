@@ -0,0 +1,46 @@
+package assembly_go
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Version은 SDK 버전입니다. 기본 User-Agent에 포함됩니다.
+const Version = "0.2.0"
+
+// DefaultUserAgent는 SDK가 기본으로 보내는 User-Agent입니다.
+const DefaultUserAgent = "assembly-go/" + Version + " (+https://github.com/leginote/assembly-go)"
+
+// BrowserUserAgent는 WithBrowserUserAgent 옵션을 켰을 때 파일 게이트웨이 요청에 사용하는 브라우저 User-Agent입니다.
+const BrowserUserAgent = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36"
+
+// requestKind는 요청 경로의 종류입니다. 종류에 따라 User-Agent가 달라질 수 있습니다.
+type requestKind int
+
+const (
+	requestOpenAPI     requestKind = iota // OpenAPI JSON 요청
+	requestFileGateway                    // 법안/회의록 PDF 다운로드 요청
+)
+
+// newRequest는 User-Agent와 기본 헤더를 적용한 요청을 생성합니다.
+// 모든 요청 경로는 이 함수를 거쳐 같은 헤더 규칙을 따릅니다.
+func (c *Client) newRequest(method, rawURL string, kind requestKind) (*http.Request, error) {
+	req, err := http.NewRequest(method, rawURL, nil) // GET 요청이므로 body는 nil
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrRequestFailed, err) // SDK 에러 사용
+	}
+
+	userAgent := DefaultUserAgent
+	if c.userAgent != "" {
+		userAgent = c.userAgent
+	}
+	if kind == requestFileGateway && c.browserUA {
+		userAgent = BrowserUserAgent
+	}
+	req.Header.Set("User-Agent", userAgent)
+
+	for key, values := range c.headers {
+		req.Header[key] = append([]string(nil), values...)
+	}
+	return req, nil
+}
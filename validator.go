@@ -0,0 +1,125 @@
+package assembly_go
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Validators는 조건부 요청(If-None-Match / If-Modified-Since)에 사용하는 캐시 검증자입니다.
+// 서버가 마지막으로 돌려준 ETag와 Last-Modified 헤더 값을 그대로 보관합니다.
+type Validators struct {
+	ETag         string `json:"etag,omitempty"`
+	LastModified string `json:"last_modified,omitempty"`
+}
+
+// IsZero는 보관된 검증자가 하나도 없는지 확인합니다.
+func (v Validators) IsZero() bool {
+	return v.ETag == "" && v.LastModified == ""
+}
+
+// ValidatorStore는 다운로드 대상(innerBillId 또는 URL)별 검증자를 저장하는 인터페이스입니다.
+// 아카이버를 다시 실행해도 변경되지 않은 파일을 건너뛸 수 있도록 영구 저장소를 구현해 연결할 수 있습니다.
+type ValidatorStore interface {
+	Get(key string) (Validators, bool)
+	Set(key string, v Validators) error
+}
+
+// MemoryValidatorStore는 프로세스 메모리에만 검증자를 보관하는 ValidatorStore 구현체입니다.
+// 별도 설정이 없을 때 Client가 기본으로 사용합니다.
+type MemoryValidatorStore struct {
+	mu    sync.RWMutex
+	items map[string]Validators
+}
+
+// NewMemoryValidatorStore는 비어있는 MemoryValidatorStore를 생성합니다.
+func NewMemoryValidatorStore() *MemoryValidatorStore {
+	return &MemoryValidatorStore{items: make(map[string]Validators)}
+}
+
+// Get은 key에 해당하는 검증자를 반환합니다.
+func (s *MemoryValidatorStore) Get(key string) (Validators, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	v, ok := s.items[key]
+	return v, ok
+}
+
+// Set은 key에 대한 검증자를 저장합니다. 비어있는 검증자는 기존 항목을 삭제합니다.
+func (s *MemoryValidatorStore) Set(key string, v Validators) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if v.IsZero() {
+		delete(s.items, key)
+		return nil
+	}
+	s.items[key] = v
+	return nil
+}
+
+// FileValidatorStore는 검증자를 JSON 파일 하나에 저장하는 ValidatorStore 구현체입니다.
+// Set이 호출될 때마다 임시 파일에 쓴 뒤 이름을 바꾸는 방식으로 파일 전체를 갱신합니다.
+type FileValidatorStore struct {
+	path string
+	mem  *MemoryValidatorStore
+	mu   sync.Mutex // 파일 쓰기 직렬화
+}
+
+// NewFileValidatorStore는 path의 JSON 파일을 읽어 FileValidatorStore를 생성합니다.
+// 파일이 존재하지 않으면 비어있는 저장소로 시작하며, 첫 Set 호출 시 파일이 만들어집니다.
+func NewFileValidatorStore(path string) (*FileValidatorStore, error) {
+	s := &FileValidatorStore{path: path, mem: NewMemoryValidatorStore()}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read validator store: %w", err)
+	}
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &s.mem.items); err != nil {
+			return nil, fmt.Errorf("failed to parse validator store %s: %w", path, err)
+		}
+	}
+	return s, nil
+}
+
+// Get은 key에 해당하는 검증자를 반환합니다.
+func (s *FileValidatorStore) Get(key string) (Validators, bool) {
+	return s.mem.Get(key)
+}
+
+// Set은 key에 대한 검증자를 저장하고 파일에 반영합니다.
+func (s *FileValidatorStore) Set(key string, v Validators) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.mem.Set(key, v); err != nil {
+		return err
+	}
+
+	s.mem.mu.RLock()
+	data, err := json.MarshalIndent(s.mem.items, "", "  ")
+	s.mem.mu.RUnlock()
+	if err != nil {
+		return fmt.Errorf("failed to encode validator store: %w", err)
+	}
+
+	if dir := filepath.Dir(s.path); dir != "" {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return fmt.Errorf("failed to create validator store directory: %w", err)
+		}
+	}
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write validator store: %w", err)
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		return fmt.Errorf("failed to write validator store: %w", err)
+	}
+	return nil
+}